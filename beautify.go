@@ -0,0 +1,127 @@
+package sdk
+
+import "errors"
+
+/**
+ * 对图片进行美颜和美白
+ * v1 支持美白和磨皮，v2 在此基础上支持瘦脸、小脸、大眼、去眉毛和滤镜。
+ * 返回美颜后图片的 base64 编码，可以通过 Result 直接解码或保存为 JPEG、PNG。
+ * v1 对象上调用仅v2支持的设置会使 End 返回 ErrBeautifyV2Only，不会发出请求。
+ */
+
+const (
+	beautifyV1APIURL = APIHost + "/facepp/beta/beautify"
+	beautifyV2APIURL = APIHost + "/facepp/v2/beautify"
+)
+
+// ErrBeautifyV2Only 在 v1 美颜对象上设置了仅v2支持的参数
+var ErrBeautifyV2Only = errors.New("该参数仅v2美颜接口支持")
+
+// BeautifyFaceResponse 美颜响应数据
+type BeautifyFaceResponse struct {
+	FaceResponse
+	Result Base64Image `json:"result"` // 美颜后的图片，jpg格式的base64编码
+}
+
+// BeautifyRequest 美颜对象
+type BeautifyRequest struct {
+	FaceRequest
+	v1 bool
+}
+
+// Beautify 构建一个美颜对象，使用 v2 接口
+func (sdk *FaceSDK) Beautify(options ...map[string]interface{}) (*BeautifyRequest, error) {
+	beautifyRequest := new(BeautifyRequest)
+	beautifyRequest.FaceRequest = sdk.newFaceRequest(beautifyV2APIURL, options)
+	return beautifyRequest, nil
+}
+
+// BeautifyV1 构建一个美颜对象，使用 v1(beta) 接口，只支持美白和磨皮，设置仅v2支持的参数时 End 返回 ErrBeautifyV2Only
+func (sdk *FaceSDK) BeautifyV1(options ...map[string]interface{}) (*BeautifyRequest, error) {
+	beautifyRequest := new(BeautifyRequest)
+	beautifyRequest.FaceRequest = sdk.newFaceRequest(beautifyV1APIURL, options)
+	beautifyRequest.v1 = true
+	return beautifyRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (br *BeautifyRequest) SetImage(img, dt string) *BeautifyRequest {
+	br.setImage(img, dt)
+	return br
+}
+
+// SetWhitening 设置美白程度，取值范围[0,100]
+func (br *BeautifyRequest) SetWhitening(whitening int) *BeautifyRequest {
+	br.options["whitening"] = whitening
+	return br
+}
+
+// SetSmoothing 设置磨皮程度，取值范围[0,100]
+func (br *BeautifyRequest) SetSmoothing(smoothing int) *BeautifyRequest {
+	br.options["smoothing"] = smoothing
+	return br
+}
+
+// SetThinFace 设置瘦脸程度，取值范围[0,100]，仅v2支持
+func (br *BeautifyRequest) SetThinFace(thinFace int) *BeautifyRequest {
+	br.setV2Option("thinface", thinFace)
+	return br
+}
+
+// SetShrinkFace 设置小脸程度，取值范围[0,100]，仅v2支持
+func (br *BeautifyRequest) SetShrinkFace(shrinkFace int) *BeautifyRequest {
+	br.setV2Option("shrink_face", shrinkFace)
+	return br
+}
+
+// SetEnlargeEye 设置大眼程度，取值范围[0,100]，仅v2支持
+func (br *BeautifyRequest) SetEnlargeEye(enlargeEye int) *BeautifyRequest {
+	br.setV2Option("enlarge_eye", enlargeEye)
+	return br
+}
+
+// SetRemoveEyebrow 设置去眉毛程度，取值范围[0,100]，仅v2支持
+func (br *BeautifyRequest) SetRemoveEyebrow(removeEyebrow int) *BeautifyRequest {
+	br.setV2Option("remove_eyebrow", removeEyebrow)
+	return br
+}
+
+// SetFilterType 设置滤镜名称，如 black_white、calm、sunny 等，仅v2支持
+func (br *BeautifyRequest) SetFilterType(filterType string) *BeautifyRequest {
+	br.setV2Option("filter_type", filterType)
+	return br
+}
+
+// setV2Option 设置仅v2支持的参数，v1 对象上记录错误
+func (br *BeautifyRequest) setV2Option(key string, val interface{}) {
+	if br.v1 {
+		br.err = ErrBeautifyV2Only
+		return
+	}
+	br.options[key] = val
+}
+
+// SetOption 设置请求参数
+func (br *BeautifyRequest) SetOption(key string, val interface{}) *BeautifyRequest {
+	br.options[key] = val
+	return br
+}
+
+// SetOptionMap 通过map设置请求参数
+func (br *BeautifyRequest) SetOptionMap(options map[string]interface{}) *BeautifyRequest {
+	for key, val := range options {
+		br.options[key] = val
+	}
+	return br
+}
+
+// End 发送请求获取结果
+func (br *BeautifyRequest) End() (*BeautifyFaceResponse, string, error) {
+	beautifyFaceResponse := new(BeautifyFaceResponse)
+	body, err := br.end(beautifyFaceResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return beautifyFaceResponse, body, nil
+}
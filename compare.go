@@ -2,14 +2,13 @@ package sdk
 
 import (
 	"encoding/json"
-	"errors"
-	"net/http"
 )
 
 /**
  * 文档地址：https://console.faceplusplus.com.cn/documents/4887586
  * 将两个人脸进行比对，来判断是否为同一个人，返回比对结果置信度和不同误识率下的阈值。
  * 支持传入图片或 face_token 进行比对。使用图片时会自动选取图片中检测到人脸尺寸最大的一个人脸。
+ * 可以通过 face_rectangle1、face_rectangle2 指定图片中的人脸，或者使用之前检测结果中的 face_token。
  */
 
 const compareAPIURL = APIBaseURL + "/compare"
@@ -17,17 +16,24 @@ const compareAPIURL = APIBaseURL + "/compare"
 // CompareFaceResponse 人脸对比响应数据
 type CompareFaceResponse struct {
 	FaceResponse
-	Confidence float32            `json:"confidence"`
-	Thresholds map[string]float32 `json:"thresholds"`
-	ImageId1   string             `json:"image_id1"`
-	ImageId2   string             `json:"image_id2"`
-	Faces1     []*Face            `json:"faces1"`
-	Faces2     []*Face            `json:"faces2"`
+	Confidence float32    `json:"confidence"`
+	Thresholds Thresholds `json:"thresholds"`
+	ImageId1   string     `json:"image_id1"`
+	ImageId2   string     `json:"image_id2"`
+	Faces1     []*Face    `json:"faces1"`
+	Faces2     []*Face    `json:"faces2"`
+	far        FAR        // 默认误识率
+}
+
+// Match 判断两个人脸在误识率level下是否为同一个人，不传level时使用 FaceSDK.DefaultFAR
+func (resp *CompareFaceResponse) Match(level ...FAR) bool {
+	return resp.Thresholds.Match(resp.Confidence, farLevel(resp.far, level))
 }
 
 // FaceCompare 人脸比对对象
 type FaceCompare struct {
 	FaceRequest
+	far FAR
 }
 
 // Compare 构建一个人脸比对对象
@@ -42,9 +48,11 @@ func (sdk *FaceSDK) Compare(options ...map[string]interface{}) (*FaceCompare, er
 	faceCompare.options["api_key"] = sdk.APIKey
 	faceCompare.options["api_secret"] = sdk.APISecret
 
+	faceCompare.far = sdk.DefaultFAR
 	faceCompare.request = sdk.getHTTPRequest().
 		Post(compareAPIURL).
 		Type("multipart")
+	faceCompare.retry = sdk.retryPolicy()
 
 	return faceCompare, nil
 }
@@ -71,6 +79,38 @@ func (fc *FaceCompare) SetFace2(face2, dt string) *FaceCompare {
 	return fc
 }
 
+// SetFaceRectangle1 指定第一张图片中要比对的人脸框，不指定时使用最大的人脸
+func (fc *FaceCompare) SetFaceRectangle1(rect FaceRectangle) *FaceCompare {
+	fc.options["face_rectangle1"] = rect.String()
+	return fc
+}
+
+// SetFaceRectangle2 指定第二张图片中要比对的人脸框，不指定时使用最大的人脸
+func (fc *FaceCompare) SetFaceRectangle2(rect FaceRectangle) *FaceCompare {
+	fc.options["face_rectangle2"] = rect.String()
+	return fc
+}
+
+// SetDetectedFace1 使用之前检测结果中的第index个人脸作为第一个人脸
+func (fc *FaceCompare) SetDetectedFace1(dr *DetectFaceResponse, index int) *FaceCompare {
+	face, err := dr.Face(index)
+	if err != nil {
+		fc.err = err
+		return fc
+	}
+	return fc.SetFace1(face.FaceToken, "face_token1")
+}
+
+// SetDetectedFace2 使用之前检测结果中的第index个人脸作为第二个人脸
+func (fc *FaceCompare) SetDetectedFace2(dr *DetectFaceResponse, index int) *FaceCompare {
+	face, err := dr.Face(index)
+	if err != nil {
+		fc.err = err
+		return fc
+	}
+	return fc.SetFace2(face.FaceToken, "face_token2")
+}
+
 // SetOption 设置请求参数
 func (fc *FaceCompare) SetOption(key string, val interface{}) *FaceCompare {
 	fc.options[key] = val
@@ -87,19 +127,19 @@ func (fc *FaceCompare) SetOptionMap(options map[string]interface{}) *FaceCompare
 
 // End 发送请求获取结果
 func (fc *FaceCompare) End() (*CompareFaceResponse, string, error) {
-	resp, body, errs := fc.request.SendMap(fc.options).End()
-	if len(errs) > 0 {
-		return nil, "", errors.New("请求接口错误:" + errs[0].Error())
+	if fc.err != nil {
+		return nil, "", fc.err
 	}
-	// 判断响应是否成功
-	if resp.StatusCode != http.StatusOK {
-		return nil, "", NewFaceError(resp.StatusCode, body)
+	body, err := fc.send()
+	if err != nil {
+		return nil, "", err
 	}
 	// 解析body为对象
 	compareFaceResponse := new(CompareFaceResponse)
-	err := json.Unmarshal([]byte(body), compareFaceResponse)
+	err = json.Unmarshal([]byte(body), compareFaceResponse)
 	if err != nil {
 		return nil, "", err
 	}
+	compareFaceResponse.far = fc.far
 	return compareFaceResponse, body, nil
 }
@@ -0,0 +1,266 @@
+package sdk
+
+import (
+	"context"
+	"math"
+	"sync"
+)
+
+/**
+ * N×M 人脸比对
+ * 分别检测两张图片中的全部人脸，两两比对 face_token 得到置信度矩阵，并可以按阈值求出一对一的最优匹配。
+ * 两张图片相同时只检测一次，比对矩阵对称，每对人脸只比对一次。
+ */
+
+// CompareMatrix 人脸比对矩阵
+type CompareMatrix struct {
+	ImageId1   string      // 第一张图片在系统中的标识
+	ImageId2   string      // 第二张图片在系统中的标识
+	Faces1     []*Face     // 第一张图片中检测出的人脸
+	Faces2     []*Face     // 第二张图片中检测出的人脸
+	Confidence [][]float32 // Confidence[i][j] 为 Faces1[i] 和 Faces2[j] 的比对置信度
+	Thresholds Thresholds  // 不同误识率下的置信度阈值，没有发起比对请求时为nil
+	far        FAR
+}
+
+// FacePair 一对匹配的人脸
+type FacePair struct {
+	Index1     int     // 人脸在 Faces1 中的下标
+	Index2     int     // 人脸在 Faces2 中的下标
+	Confidence float32 // 比对置信度
+}
+
+/**
+ * CompareMatrix 比对两张图片中的全部人脸
+ * @param img1 第一张图片
+ * @param dt1 可以是(image_url|image_file|image_base64)
+ * @param img2 第二张图片
+ * @param dt2 可以是(image_url|image_file|image_base64)
+ * @param concurrency 并发请求数，小于等于0时使用默认值
+ */
+func (sdk *FaceSDK) CompareMatrix(ctx context.Context, img1, dt1, img2, dt2 string, concurrency int) (*CompareMatrix, error) {
+	dr1, err := sdk.detectImage(ctx, img1, dt1)
+	if err != nil {
+		return nil, err
+	}
+	dr2 := dr1
+	if img1 != img2 || dt1 != dt2 {
+		if dr2, err = sdk.detectImage(ctx, img2, dt2); err != nil {
+			return nil, err
+		}
+	}
+
+	matrix := &CompareMatrix{
+		ImageId1:   dr1.ImageId,
+		ImageId2:   dr2.ImageId,
+		Faces1:     dr1.Faces,
+		Faces2:     dr2.Faces,
+		Confidence: make([][]float32, len(dr1.Faces)),
+		far:        sdk.DefaultFAR,
+	}
+	for i := range matrix.Confidence {
+		matrix.Confidence[i] = make([]float32, len(dr2.Faces))
+	}
+
+	// 按无序face_token对去重，相同的两个人脸只比对一次
+	type tokenPair [2]string
+	pairs := make([]tokenPair, 0)
+	cache := make(map[tokenPair]float32)
+	for _, f1 := range dr1.Faces {
+		for _, f2 := range dr2.Faces {
+			key := tokenPair{f1.FaceToken, f2.FaceToken}
+			if key[0] > key[1] {
+				key[0], key[1] = key[1], key[0]
+			}
+			if _, ok := cache[key]; ok {
+				continue
+			}
+			cache[key] = 0
+			if key[0] == key[1] {
+				// 同一个人脸
+				cache[key] = sameFaceConfidence
+				continue
+			}
+			pairs = append(pairs, key)
+		}
+	}
+
+	var mu sync.Mutex
+	err = parallel(ctx, len(pairs), concurrency, func(i int) error {
+		compare, err := sdk.Compare()
+		if err != nil {
+			return err
+		}
+		compare.withContext(ctx)
+		cr, _, err := compare.SetFace1(pairs[i][0], "face_token1").
+			SetFace2(pairs[i][1], "face_token2").
+			End()
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		cache[pairs[i]] = cr.Confidence
+		if matrix.Thresholds == nil {
+			matrix.Thresholds = cr.Thresholds
+		}
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, f1 := range dr1.Faces {
+		for j, f2 := range dr2.Faces {
+			key := tokenPair{f1.FaceToken, f2.FaceToken}
+			if key[0] > key[1] {
+				key[0], key[1] = key[1], key[0]
+			}
+			matrix.Confidence[i][j] = cache[key]
+		}
+	}
+	return matrix, nil
+}
+
+// 检测图片中的全部人脸
+func (sdk *FaceSDK) detectImage(ctx context.Context, img, dt string) (*DetectFaceResponse, error) {
+	detect, err := sdk.Detect()
+	if err != nil {
+		return nil, err
+	}
+	detect.withContext(ctx)
+	dr, _, err := detect.SetImage(img, dt).End()
+	return dr, err
+}
+
+// 同一个 face_token 的置信度，高于任何误识率下的阈值
+const sameFaceConfidence = 100
+
+/**
+ * AssignAt 按误识率level对应的阈值求一对一最优匹配，不传level时使用 FaceSDK.DefaultFAR
+ * 所有人脸对都是同一个 face_token 时不会发起比对请求，没有 Thresholds，此时只匹配置信度为100的同一个人脸
+ */
+func (cm *CompareMatrix) AssignAt(level ...FAR) []FacePair {
+	threshold, ok := cm.Thresholds.At(farLevel(cm.far, level))
+	if !ok {
+		threshold = sameFaceConfidence
+	}
+	return cm.Assign(threshold)
+}
+
+/**
+ * Assign 求一对一的最优匹配，使匹配的人脸置信度之和最大
+ * 每个人脸最多出现在一个匹配中，置信度低于threshold的人脸对不参与匹配
+ */
+func (cm *CompareMatrix) Assign(threshold float32) []FacePair {
+	n, m := len(cm.Faces1), len(cm.Faces2)
+	pairs := make([]FacePair, 0)
+	if n == 0 || m == 0 {
+		return pairs
+	}
+	weight := make([][]float64, n)
+	for i := range weight {
+		weight[i] = make([]float64, m)
+		for j := 0; j < m; j++ {
+			if cm.Confidence[i][j] >= threshold {
+				weight[i][j] = float64(cm.Confidence[i][j])
+			}
+		}
+	}
+	for i, j := range maxWeightAssignment(weight) {
+		if j >= 0 && cm.Confidence[i][j] >= threshold && weight[i][j] > 0 {
+			pairs = append(pairs, FacePair{
+				Index1:     i,
+				Index2:     j,
+				Confidence: cm.Confidence[i][j],
+			})
+		}
+	}
+	return pairs
+}
+
+// maxWeightAssignment 匈牙利算法求最大权匹配，返回每一行匹配的列下标，未匹配为-1
+func maxWeightAssignment(weight [][]float64) []int {
+	n := len(weight)
+	m := len(weight[0])
+	size := n
+	if m > size {
+		size = m
+	}
+	// 补齐为方阵并转换为最小代价
+	maxWeight := 0.0
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			maxWeight = math.Max(maxWeight, weight[i][j])
+		}
+	}
+	cost := func(i, j int) float64 {
+		if i < n && j < m {
+			return maxWeight - weight[i][j]
+		}
+		return maxWeight
+	}
+
+	// 下标从1开始，p[j]为第j列匹配的行，0表示未匹配
+	u := make([]float64, size+1)
+	v := make([]float64, size+1)
+	p := make([]int, size+1)
+	way := make([]int, size+1)
+	for i := 1; i <= size; i++ {
+		p[0] = i
+		j0 := 0
+		minv := make([]float64, size+1)
+		used := make([]bool, size+1)
+		for j := range minv {
+			minv[j] = math.Inf(1)
+		}
+		for {
+			used[j0] = true
+			i0 := p[j0]
+			delta := math.Inf(1)
+			j1 := 0
+			for j := 1; j <= size; j++ {
+				if used[j] {
+					continue
+				}
+				cur := cost(i0-1, j-1) - u[i0] - v[j]
+				if cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			for j := 0; j <= size; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	assignment := make([]int, n)
+	for i := range assignment {
+		assignment[i] = -1
+	}
+	for j := 1; j <= size; j++ {
+		if p[j] > 0 && p[j] <= n && j <= m {
+			assignment[p[j]-1] = j - 1
+		}
+	}
+	return assignment
+}
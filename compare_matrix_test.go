@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// 穷举所有一对一匹配，返回最大权重之和
+func bruteForceAssignment(weight [][]float64) float64 {
+	n, m := len(weight), len(weight[0])
+	best := 0.0
+	used := make([]bool, m)
+	var search func(i int, sum float64)
+	search = func(i int, sum float64) {
+		if i == n {
+			if sum > best {
+				best = sum
+			}
+			return
+		}
+		search(i+1, sum)
+		for j := 0; j < m; j++ {
+			if !used[j] {
+				used[j] = true
+				search(i+1, sum+weight[i][j])
+				used[j] = false
+			}
+		}
+	}
+	search(0, 0)
+	return best
+}
+
+func TestMaxWeightAssignment(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for k := 0; k < 3000; k++ {
+		n, m := r.Intn(6)+1, r.Intn(6)+1
+		weight := make([][]float64, n)
+		for i := range weight {
+			weight[i] = make([]float64, m)
+			for j := range weight[i] {
+				if r.Intn(3) > 0 {
+					weight[i][j] = float64(r.Intn(100))
+				}
+			}
+		}
+		assignment := maxWeightAssignment(weight)
+		if len(assignment) != n {
+			t.Fatalf("%v: assignment length %d, want %d", weight, len(assignment), n)
+		}
+		sum := 0.0
+		used := make(map[int]bool)
+		for i, j := range assignment {
+			if j < 0 {
+				continue
+			}
+			if used[j] {
+				t.Fatalf("%v: column %d assigned twice in %v", weight, j, assignment)
+			}
+			used[j] = true
+			sum += weight[i][j]
+		}
+		if want := bruteForceAssignment(weight); sum != want {
+			t.Fatalf("%v: assignment %v sums to %v, want %v", weight, assignment, sum, want)
+		}
+	}
+}
+
+func TestCompareMatrixAssign(t *testing.T) {
+	cm := &CompareMatrix{
+		Faces1: make([]*Face, 2),
+		Faces2: make([]*Face, 3),
+		Confidence: [][]float32{
+			{90, 80, 10},
+			{85, 20, 30},
+		},
+		Thresholds: Thresholds{"1e-3": 62, "1e-4": 69, "1e-5": 74},
+	}
+	// 贪心会选择 0-0，最优解为 0-1 和 1-0
+	pairs := cm.AssignAt(FAR1e4)
+	if len(pairs) != 2 || pairs[0].Index2 != 1 || pairs[1].Index2 != 0 {
+		t.Fatalf("AssignAt = %+v", pairs)
+	}
+	// 低于阈值的人脸对不参与匹配
+	if pairs := cm.Assign(88); len(pairs) != 1 || pairs[0].Index1 != 0 || pairs[0].Index2 != 0 {
+		t.Fatalf("Assign(88) = %+v", pairs)
+	}
+}
+
+func TestCompareMatrixAssignWithoutThresholds(t *testing.T) {
+	// 两边是同一张只有一个人脸的图片，没有发起比对请求
+	cm := &CompareMatrix{
+		Faces1:     make([]*Face, 1),
+		Faces2:     make([]*Face, 1),
+		Confidence: [][]float32{{sameFaceConfidence}},
+	}
+	if pairs := cm.AssignAt(); len(pairs) != 1 {
+		t.Fatalf("AssignAt = %+v, want the identical face matched", pairs)
+	}
+	cm.Confidence[0][0] = 99
+	if pairs := cm.AssignAt(); len(pairs) != 0 {
+		t.Fatalf("AssignAt = %+v, want no match without thresholds", pairs)
+	}
+}
@@ -2,8 +2,7 @@ package sdk
 
 import (
 	"encoding/json"
-	"errors"
-	"net/http"
+	"fmt"
 )
 
 /**
@@ -22,6 +21,14 @@ type DetectFaceResponse struct {
 	Faces   []*Face `json:"faces"`    // 被检测出的人脸数组，具体包含内容见下文 注：如果没有检测出人脸则为空数组
 }
 
+// Face 返回第index个检测出的人脸
+func (dr *DetectFaceResponse) Face(index int) (*Face, error) {
+	if dr == nil || index < 0 || index >= len(dr.Faces) {
+		return nil, fmt.Errorf("检测结果中不存在第%d个人脸", index)
+	}
+	return dr.Faces[index], nil
+}
+
 // FaceDetect 人脸检测和人脸分析对象
 type FaceDetect struct {
 	FaceRequest
@@ -43,6 +50,7 @@ func (sdk *FaceSDK) Detect(options ...map[string]interface{}) (*FaceDetect, erro
 	faceDetect.request = sdk.getHTTPRequest().
 		Post(detectAPIURL).
 		Type("multipart")
+	faceDetect.retry = sdk.retryPolicy()
 
 	return faceDetect, nil
 }
@@ -74,17 +82,13 @@ func (fd *FaceDetect) SetOptionMap(options map[string]interface{}) *FaceDetect {
 
 // End 发送请求获取结果
 func (fd *FaceDetect) End() (*DetectFaceResponse, string, error) {
-	resp, body, errs := fd.request.SendMap(fd.options).End()
-	if len(errs) > 0 {
-		return nil, "", errors.New("请求接口错误:" + errs[0].Error())
-	}
-	// 判断响应是否成功
-	if resp.StatusCode != http.StatusOK {
-		return nil, "", NewFaceError(resp.StatusCode, body)
+	body, err := fd.send()
+	if err != nil {
+		return nil, "", err
 	}
 	// 解析body为对象
 	detectFaceResponse := new(DetectFaceResponse)
-	err := json.Unmarshal([]byte(body), detectFaceResponse)
+	err = json.Unmarshal([]byte(body), detectFaceResponse)
 	if err != nil {
 		return nil, "", err
 	}
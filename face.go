@@ -27,6 +27,7 @@ func (sdk *FaceSDK) Face(options ...map[string]interface{}) (*FaceAPIRequest, er
 
 	faceAPIRequest.request = sdk.getHTTPRequest().
 		Type("multipart")
+	faceAPIRequest.retry = sdk.retryPolicy()
 
 	return faceAPIRequest, nil
 }
@@ -0,0 +1,180 @@
+package sdk
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/**
+ * 3D人脸重建
+ * 传入一张正脸图片（可选再传入两张侧脸图片），返回 3D 人脸模型的 obj 文件、纹理图片和 mtl 材质文件，均为 base64 编码。
+ * 结果可以保存为可直接打开的 .obj + .mtl + 纹理图片，或者打包为 zip。
+ */
+
+const face3DAPIURL = APIHost + "/facepp/v1/3dface"
+
+// Face3DFaceResponse 3D人脸重建响应数据
+type Face3DFaceResponse struct {
+	FaceResponse
+	ObjFile        string      `json:"obj_file"`        // obj 模型文件的 base64 编码
+	TextureImg     Base64Image `json:"texture_img"`     // 纹理图片的 base64 编码
+	MtlFile        string      `json:"mtl_file"`        // mtl 材质文件的 base64 编码
+	TransferMatrix [][]float64 `json:"transfer_matrix"` // 模型坐标到图片坐标的转换矩阵
+}
+
+// Face3DRequest 3D人脸重建对象
+type Face3DRequest struct {
+	FaceRequest
+}
+
+// Face3D 构建一个3D人脸重建对象，默认返回纹理图片和mtl文件
+func (sdk *FaceSDK) Face3D(options ...map[string]interface{}) (*Face3DRequest, error) {
+	face3DRequest := new(Face3DRequest)
+	face3DRequest.FaceRequest = sdk.newFaceRequest(face3DAPIURL, options)
+	for _, key := range []string{"texture", "mtl"} {
+		if _, ok := face3DRequest.options[key]; !ok {
+			face3DRequest.options[key] = 1
+		}
+	}
+	return face3DRequest, nil
+}
+
+// SetImage 设置正脸图片
+// dt可以是(image_url_1|image_file_1|image_base64_1)
+func (f3r *Face3DRequest) SetImage(img, dt string) *Face3DRequest {
+	f3r.setImage(img, dt)
+	return f3r
+}
+
+// SetSideImages 设置两张侧脸图片，用于提高重建效果
+// dt2可以是(image_url_2|image_file_2|image_base64_2)，dt3可以是(image_url_3|image_file_3|image_base64_3)
+func (f3r *Face3DRequest) SetSideImages(img2, dt2, img3, dt3 string) *Face3DRequest {
+	f3r.setImage(img2, dt2)
+	f3r.setImage(img3, dt3)
+	return f3r
+}
+
+// SetOption 设置请求参数
+func (f3r *Face3DRequest) SetOption(key string, val interface{}) *Face3DRequest {
+	f3r.options[key] = val
+	return f3r
+}
+
+// SetOptionMap 通过map设置请求参数
+func (f3r *Face3DRequest) SetOptionMap(options map[string]interface{}) *Face3DRequest {
+	for key, val := range options {
+		f3r.options[key] = val
+	}
+	return f3r
+}
+
+// End 发送请求获取结果
+func (f3r *Face3DRequest) End() (*Face3DFaceResponse, string, error) {
+	face3DFaceResponse := new(Face3DFaceResponse)
+	body, err := f3r.end(face3DFaceResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return face3DFaceResponse, body, nil
+}
+
+/**
+ * Files 返回模型文件名和内容，name为不含扩展名的文件名
+ * obj 中的 mtllib 和 mtl 中的 map_Kd 会改写为对应的文件名，保证三个文件放在同一目录即可直接打开
+ */
+func (resp *Face3DFaceResponse) Files(name string) (map[string][]byte, error) {
+	objName, mtlName, textureName := name+".obj", name+".mtl", name+".jpg"
+	files := make(map[string][]byte, 3)
+
+	obj, err := base64.StdEncoding.DecodeString(resp.ObjFile)
+	if err != nil {
+		return nil, err
+	}
+	if resp.MtlFile != "" {
+		mtl, err := base64.StdEncoding.DecodeString(resp.MtlFile)
+		if err != nil {
+			return nil, err
+		}
+		if resp.TextureImg != "" {
+			mtl = rewriteDirective(mtl, "map_Kd", textureName, false)
+		}
+		files[mtlName] = mtl
+		obj = rewriteDirective(obj, "mtllib", mtlName, true)
+	}
+	files[objName] = obj
+	if resp.TextureImg != "" {
+		texture, err := resp.TextureImg.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		files[textureName] = texture
+	}
+	return files, nil
+}
+
+// SaveBundle 将模型保存到dir目录，文件名为 name.obj、name.mtl、name.jpg
+func (resp *Face3DFaceResponse) SaveBundle(dir, name string) error {
+	files, err := resp.Files(name)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for filename, data := range files {
+		if err = os.WriteFile(filepath.Join(dir, filename), data, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteZip 将模型打包为zip写入w，文件名为 name.obj、name.mtl、name.jpg
+func (resp *Face3DFaceResponse) WriteZip(w io.Writer, name string) error {
+	files, err := resp.Files(name)
+	if err != nil {
+		return err
+	}
+	zw := zip.NewWriter(w)
+	for _, filename := range []string{name + ".obj", name + ".mtl", name + ".jpg"} {
+		data, ok := files[filename]
+		if !ok {
+			continue
+		}
+		fw, err := zw.Create(filename)
+		if err != nil {
+			return err
+		}
+		if _, err = fw.Write(data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// 将文本文件中以directive开头的行的参数改写为value，不存在且prepend为true时在文件开头添加
+func rewriteDirective(data []byte, directive, value string, prepend bool) []byte {
+	var buf bytes.Buffer
+	found := false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(strings.TrimSpace(line), directive+" ") {
+			line = directive + " " + value
+			found = true
+		}
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+	if !found && prepend {
+		return append([]byte(directive+" "+value+"\n"), buf.Bytes()...)
+	}
+	return buf.Bytes()
+}
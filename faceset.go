@@ -2,10 +2,7 @@ package sdk
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
-	"log"
-	"net/http"
 )
 
 /**
@@ -81,6 +78,20 @@ type FaceSetGetFaceSetsFaceFaceResponse struct {
 	Next     string     `json:"next"`     // 用于进行下一次请求。返回值表示排在此次返回的所有 face_token 之后的下一个 face_token 的序号
 }
 
+// FaceSetAsyncTaskFaceResponse 异步添加、移除face_token响应
+type FaceSetAsyncTaskFaceResponse struct {
+	FaceResponse
+	TaskId string `json:"task_id"` // 异步任务的标识，用于查询任务状态
+}
+
+// FaceSetTaskStatusFaceResponse 查询异步任务状态响应
+type FaceSetTaskStatusFaceResponse struct {
+	FaceSetBaseFaceResponse
+	Status      int `json:"status"`       // 任务状态 0 任务进行中 1 任务已完成
+	FaceAdded   int `json:"face_added"`   // 添加任务成功加入 FaceSet 的 face_token 数量
+	FaceRemoved int `json:"face_removed"` // 移除任务成功从 FaceSet 中移除的 face_token 数量
+}
+
 // FaceSetRequest FaceSet管理对象
 type FaceSetRequest struct {
 	FaceRequest
@@ -109,6 +120,7 @@ func (sdk *FaceSDK) FaceSet(options ...map[string]interface{}) (*FaceSetRequest,
 
 	faceSetRequest.request = sdk.getHTTPRequest()
 	faceSetRequest.request.Debug = sdk.Debug
+	faceSetRequest.retry = sdk.retryPolicy()
 
 	return faceSetRequest, nil
 }
@@ -130,7 +142,6 @@ func (fsr *FaceSetRequest) SetOptionMap(options map[string]interface{}) *FaceSet
 // Create 创建一个人脸集合
 func (fsr *FaceSetRequest) Create() *FaceSetRequest {
 	urlStr := fmt.Sprintf("%s/create", facesetAPIURL)
-	log.Println(urlStr)
 	fsr.request.Post(urlStr)
 	fsr.response = new(FaceSetCreateFaceResponse)
 	return fsr
@@ -184,21 +195,39 @@ func (fsr *FaceSetRequest) GetFaceSets() *FaceSetRequest {
 	return fsr
 }
 
+// AsyncAddFace 异步添加人脸标识 face_token到FaceSet
+func (fsr *FaceSetRequest) AsyncAddFace() *FaceSetRequest {
+	urlStr := fmt.Sprintf("%s/async/addface", facesetAPIURL)
+	fsr.request.Post(urlStr)
+	fsr.response = new(FaceSetAsyncTaskFaceResponse)
+	return fsr
+}
+
+// AsyncRemoveFace 异步移除一个FaceSet中的某些或者全部face_token
+func (fsr *FaceSetRequest) AsyncRemoveFace() *FaceSetRequest {
+	urlStr := fmt.Sprintf("%s/async/removeface", facesetAPIURL)
+	fsr.request.Post(urlStr)
+	fsr.response = new(FaceSetAsyncTaskFaceResponse)
+	return fsr
+}
+
+// TaskStatus 查询异步添加、移除任务的状态
+func (fsr *FaceSetRequest) TaskStatus() *FaceSetRequest {
+	urlStr := fmt.Sprintf("%s/async/task_status", facesetAPIURL)
+	fsr.request.Post(urlStr)
+	fsr.response = new(FaceSetTaskStatusFaceResponse)
+	return fsr
+}
+
 // End 发送请求获取结果
 func (fsr *FaceSetRequest) End() (interface{}, string, error) {
-	log.Println(fsr.options)
-	log.Println(fsr.request.Url)
-
-	resp, body, errs := fsr.request.Type("multipart").SendMap(fsr.options).End()
-	if len(errs) > 0 {
-		return nil, "", errors.New("请求接口错误:" + errs[0].Error())
-	}
-	// 判断响应是否成功
-	if resp.StatusCode != http.StatusOK {
-		return nil, "", NewFaceError(resp.StatusCode, body)
+	fsr.request.Type("multipart")
+	body, err := fsr.send()
+	if err != nil {
+		return nil, "", err
 	}
 	// 解析body为对象
-	err := json.Unmarshal([]byte(body), fsr.response)
+	err = json.Unmarshal([]byte(body), fsr.response)
 	if err != nil {
 		return nil, "", err
 	}
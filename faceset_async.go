@@ -0,0 +1,151 @@
+package sdk
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+/**
+ * 异步添加、移除 FaceSet 中的 face_token，适合大批量入库。提交后返回 task_id，通过 task_status 接口查询任务进度。
+ */
+
+// DefaultTaskPollInterval 查询异步任务状态的默认间隔
+const DefaultTaskPollInterval = time.Second
+
+// RemoveAllFaceTokens 作为 face_tokens 传入时移除 FaceSet 中的全部 face_token
+const RemoveAllFaceTokens = "RemoveAllFaceTokens"
+
+const (
+	FaceSetTaskStatusRunning  = 0 // 任务进行中
+	FaceSetTaskStatusFinished = 1 // 任务已完成
+)
+
+// FaceSetTask 异步任务句柄
+type FaceSetTask struct {
+	TaskId   string        // 异步任务的标识
+	Interval time.Duration // 查询任务状态的间隔，为0时使用默认值
+	sdk      *FaceSDK
+}
+
+// FaceSetAddFaceTask 异步添加face_token任务
+type FaceSetAddFaceTask struct {
+	*FaceSetTask
+}
+
+// FaceSetRemoveFaceTask 异步移除face_token任务
+type FaceSetRemoveFaceTask struct {
+	*FaceSetTask
+}
+
+/**
+ * AsyncAddFaces 提交异步添加 face_token 到 FaceSet 的任务
+ * @param set FaceSet 标识
+ * @param dt 可以是(faceset_token|outer_id)
+ * @param faceTokens 要添加的 face_token 数组
+ */
+func (sdk *FaceSDK) AsyncAddFaces(set, dt string, faceTokens []string) (*FaceSetAddFaceTask, error) {
+	task, err := sdk.submitFaceSetTask(set, dt, faceTokens, (*FaceSetRequest).AsyncAddFace)
+	if err != nil {
+		return nil, err
+	}
+	return &FaceSetAddFaceTask{task}, nil
+}
+
+/**
+ * AsyncRemoveFaces 提交异步从 FaceSet 移除 face_token 的任务
+ * @param set FaceSet 标识
+ * @param dt 可以是(faceset_token|outer_id)
+ * @param faceTokens 要移除的 face_token 数组，也可以传入 []string{RemoveAllFaceTokens} 移除全部
+ */
+func (sdk *FaceSDK) AsyncRemoveFaces(set, dt string, faceTokens []string) (*FaceSetRemoveFaceTask, error) {
+	task, err := sdk.submitFaceSetTask(set, dt, faceTokens, (*FaceSetRequest).AsyncRemoveFace)
+	if err != nil {
+		return nil, err
+	}
+	return &FaceSetRemoveFaceTask{task}, nil
+}
+
+// FaceSetTask 根据已有的 task_id 创建任务句柄，用于恢复对之前提交任务的查询
+func (sdk *FaceSDK) FaceSetTask(taskId string) *FaceSetTask {
+	return &FaceSetTask{
+		TaskId: taskId,
+		sdk:    sdk,
+	}
+}
+
+// 提交异步任务
+func (sdk *FaceSDK) submitFaceSetTask(set, dt string, faceTokens []string, op func(*FaceSetRequest) *FaceSetRequest) (*FaceSetTask, error) {
+	resp, err := sdk.doFaceSet(map[string]interface{}{
+		dt:            set,
+		"face_tokens": strings.Join(faceTokens, ","),
+	}, op)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.FaceSetTask(resp.(*FaceSetAsyncTaskFaceResponse).TaskId), nil
+}
+
+// Status 查询一次任务状态
+func (task *FaceSetTask) Status() (*FaceSetTaskStatusFaceResponse, error) {
+	return task.status(context.Background())
+}
+
+// 查询一次任务状态，重试等待时监听ctx
+func (task *FaceSetTask) status(ctx context.Context) (*FaceSetTaskStatusFaceResponse, error) {
+	resp, err := task.sdk.doFaceSetContext(ctx, map[string]interface{}{
+		"task_id": task.TaskId,
+	}, (*FaceSetRequest).TaskStatus)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FaceSetTaskStatusFaceResponse), nil
+}
+
+// Wait 按间隔轮询任务状态直到任务完成或ctx结束
+func (task *FaceSetTask) Wait(ctx context.Context) (*FaceSetTaskStatusFaceResponse, error) {
+	interval := task.Interval
+	if interval <= 0 {
+		interval = DefaultTaskPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		status, err := task.status(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if status.Status == FaceSetTaskStatusFinished {
+			return status, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Wait 等待添加任务完成，返回与同步 AddFace 相同的结果
+func (task *FaceSetAddFaceTask) Wait(ctx context.Context) (*FaceSetAddFaceFaceResponse, error) {
+	status, err := task.FaceSetTask.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := new(FaceSetAddFaceFaceResponse)
+	resp.FaceSetBaseFaceResponse = status.FaceSetBaseFaceResponse
+	resp.FaceAdded = status.FaceAdded
+	return resp, nil
+}
+
+// Wait 等待移除任务完成，返回与同步 RemoveFace 相同的结果
+func (task *FaceSetRemoveFaceTask) Wait(ctx context.Context) (*FaceSetRemoveFaceFaceResponse, error) {
+	status, err := task.FaceSetTask.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := new(FaceSetRemoveFaceFaceResponse)
+	resp.FaceSetBaseFaceResponse = status.FaceSetBaseFaceResponse
+	resp.FaceRemoved = status.FaceRemoved
+	return resp, nil
+}
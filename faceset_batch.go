@@ -0,0 +1,124 @@
+package sdk
+
+import (
+	"context"
+	"strings"
+)
+
+/**
+ * FaceSet 批量添加、移除 face_token
+ * addface 和 removeface 接口单次最多传入 5 个 face_token，这里自动拆分请求，按指定并发数执行并合并结果。
+ */
+
+// FaceSetMaxFaceTokensPerRequest addface、removeface 单次请求最多可传入的 face_token 数量
+const FaceSetMaxFaceTokensPerRequest = 5
+
+// 创建FaceSet操作对象并执行op对应的接口，返回解析后的响应对象
+func (sdk *FaceSDK) doFaceSet(options map[string]interface{}, op func(*FaceSetRequest) *FaceSetRequest) (interface{}, error) {
+	return sdk.doFaceSetContext(context.Background(), options, op)
+}
+
+// 同doFaceSet，重试等待时监听ctx
+func (sdk *FaceSDK) doFaceSetContext(ctx context.Context, options map[string]interface{}, op func(*FaceSetRequest) *FaceSetRequest) (interface{}, error) {
+	fsr, err := sdk.FaceSet(options)
+	if err != nil {
+		return nil, err
+	}
+	fsr.withContext(ctx)
+	resp, _, err := op(fsr).End()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+/**
+ * AddFaces 批量添加人脸标识 face_token 到 FaceSet，face_token 数量不受单次请求 5 个的限制
+ * 某一组请求失败后不再发起新的请求，返回已完成部分的合并结果和错误
+ * @param set FaceSet 标识
+ * @param dt 可以是(faceset_token|outer_id)
+ * @param faceTokens 要添加的 face_token 数组
+ * @param concurrency 并发请求数，小于等于0时使用默认值
+ */
+func (sdk *FaceSDK) AddFaces(ctx context.Context, set, dt string, faceTokens []string, concurrency int) (*FaceSetAddFaceFaceResponse, error) {
+	chunks := splitStrings(faceTokens, FaceSetMaxFaceTokensPerRequest)
+	results := make([]*FaceSetAddFaceFaceResponse, len(chunks))
+	err := parallel(ctx, len(chunks), concurrency, func(i int) error {
+		resp, err := sdk.doFaceSetContext(ctx, map[string]interface{}{
+			dt:            set,
+			"face_tokens": strings.Join(chunks[i], ","),
+		}, (*FaceSetRequest).AddFace)
+		if err != nil {
+			return err
+		}
+		results[i] = resp.(*FaceSetAddFaceFaceResponse)
+		return nil
+	})
+
+	merged := new(FaceSetAddFaceFaceResponse)
+	merged.FailureDetail = make([]*FailureDetail, 0)
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		mergeFaceSetBaseFaceResponse(&merged.FaceSetBaseFaceResponse, &result.FaceSetBaseFaceResponse)
+		merged.FaceAdded += result.FaceAdded
+		// 添加操作后数量只增不减，最大值即为最终数量
+		if result.FaceCount > merged.FaceCount {
+			merged.FaceCount = result.FaceCount
+		}
+	}
+	return merged, err
+}
+
+/**
+ * RemoveFaces 批量从 FaceSet 中移除 face_token，face_token 数量不受单次请求 5 个的限制
+ * 某一组请求失败后不再发起新的请求，返回已完成部分的合并结果和错误
+ * @param set FaceSet 标识
+ * @param dt 可以是(faceset_token|outer_id)
+ * @param faceTokens 要移除的 face_token 数组
+ * @param concurrency 并发请求数，小于等于0时使用默认值
+ */
+func (sdk *FaceSDK) RemoveFaces(ctx context.Context, set, dt string, faceTokens []string, concurrency int) (*FaceSetRemoveFaceFaceResponse, error) {
+	chunks := splitStrings(faceTokens, FaceSetMaxFaceTokensPerRequest)
+	results := make([]*FaceSetRemoveFaceFaceResponse, len(chunks))
+	err := parallel(ctx, len(chunks), concurrency, func(i int) error {
+		resp, err := sdk.doFaceSetContext(ctx, map[string]interface{}{
+			dt:            set,
+			"face_tokens": strings.Join(chunks[i], ","),
+		}, (*FaceSetRequest).RemoveFace)
+		if err != nil {
+			return err
+		}
+		results[i] = resp.(*FaceSetRemoveFaceFaceResponse)
+		return nil
+	})
+
+	merged := new(FaceSetRemoveFaceFaceResponse)
+	merged.FailureDetail = make([]*FailureDetail, 0)
+	first := true
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		mergeFaceSetBaseFaceResponse(&merged.FaceSetBaseFaceResponse, &result.FaceSetBaseFaceResponse)
+		merged.FaceRemoved += result.FaceRemoved
+		// 移除操作后数量只减不增，最小值即为最终数量
+		if first || result.FaceCount < merged.FaceCount {
+			merged.FaceCount = result.FaceCount
+		}
+		first = false
+	}
+	return merged, err
+}
+
+// 合并单次请求的公共响应字段，FaceCount 由调用方处理
+func mergeFaceSetBaseFaceResponse(merged, result *FaceSetBaseFaceResponse) {
+	if merged.RequestId == "" {
+		merged.RequestId = result.RequestId
+	}
+	merged.TimeUsed += result.TimeUsed
+	merged.FacesetToken = result.FacesetToken
+	merged.OuterId = result.OuterId
+	merged.FailureDetail = append(merged.FailureDetail, result.FailureDetail...)
+}
@@ -0,0 +1,121 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+/**
+ * 幂等地创建 FaceSet
+ * 使用已存在的 outer_id 创建 FaceSet 时接口返回 FACESET_EXIST，多个服务同时部署时会互相冲突。
+ * EnsureFaceSet 将该错误视为成功，并可选地把已存在 FaceSet 的属性更新为期望值。
+ */
+
+// FaceSetAttrs FaceSet 的可选属性
+type FaceSetAttrs struct {
+	DisplayName string // 人脸集合的名字
+	Tags        string // 自定义标签，多个标签用逗号分隔
+	UserData    string // 自定义用户信息，不能包含字符 ^@,&=*'"
+	Reconcile   bool   // FaceSet 已存在时，是否将不一致的非空属性更新为以上值
+}
+
+/**
+ * EnsureFaceSet 创建或获取 outer_id 对应的 FaceSet，返回当前的 FaceSet 详情
+ * @param outerID 用户自定义的 FaceSet 标识
+ * @param attrs 创建时使用的属性，可以为nil
+ */
+func (sdk *FaceSDK) EnsureFaceSet(ctx context.Context, outerID string, attrs *FaceSetAttrs) (*FaceSetGetDetailFaceFaceResponse, error) {
+	if attrs == nil {
+		attrs = new(FaceSetAttrs)
+	}
+	if err := attrs.validate(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	options := attrs.options()
+	options["outer_id"] = outerID
+	_, err := sdk.doFaceSetContext(ctx, options, (*FaceSetRequest).Create)
+	created := err == nil
+	if err != nil && !IsFaceError(err, ErrorFaceSetExist) {
+		return nil, err
+	}
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+	detail, err := sdk.getFaceSetDetail(outerID, "outer_id")
+	if err != nil {
+		return nil, err
+	}
+	if created || !attrs.Reconcile {
+		return detail, nil
+	}
+
+	// 只更新和期望值不一致的属性
+	update := make(map[string]interface{})
+	if attrs.DisplayName != "" && attrs.DisplayName != detail.DisplayName {
+		update["display_name"] = attrs.DisplayName
+	}
+	if attrs.Tags != "" && attrs.Tags != detail.Tags {
+		update["tags"] = attrs.Tags
+	}
+	if attrs.UserData != "" && attrs.UserData != detail.UserData {
+		update["user_data"] = attrs.UserData
+	}
+	if len(update) == 0 {
+		return detail, nil
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+	update["outer_id"] = outerID
+	if _, err = sdk.doFaceSetContext(ctx, update, (*FaceSetRequest).Update); err != nil {
+		return nil, err
+	}
+	return sdk.getFaceSetDetail(outerID, "outer_id")
+}
+
+// faceSetAttrInvalidChars 每个标签和 user_data 中不允许出现的字符
+const faceSetAttrInvalidChars = "^@,&=*'\""
+
+// 检查 tags 和 user_data 中是否包含接口不允许的字符，tags 以逗号分隔
+func (attrs *FaceSetAttrs) validate() error {
+	for _, tag := range strings.Split(attrs.Tags, ",") {
+		if strings.ContainsAny(tag, faceSetAttrInvalidChars) {
+			return fmt.Errorf("标签 %s 不能包含字符 %s", tag, faceSetAttrInvalidChars)
+		}
+	}
+	if strings.ContainsAny(attrs.UserData, faceSetAttrInvalidChars) {
+		return fmt.Errorf("user_data 不能包含字符 %s", faceSetAttrInvalidChars)
+	}
+	return nil
+}
+
+// 将非空属性转换为请求参数
+func (attrs *FaceSetAttrs) options() map[string]interface{} {
+	options := make(map[string]interface{})
+	if attrs.DisplayName != "" {
+		options["display_name"] = attrs.DisplayName
+	}
+	if attrs.Tags != "" {
+		options["tags"] = attrs.Tags
+	}
+	if attrs.UserData != "" {
+		options["user_data"] = attrs.UserData
+	}
+	return options
+}
+
+// 获取 FaceSet 详情，dt可以是(faceset_token|outer_id)
+func (sdk *FaceSDK) getFaceSetDetail(set, dt string) (*FaceSetGetDetailFaceFaceResponse, error) {
+	resp, err := sdk.doFaceSet(map[string]interface{}{
+		dt: set,
+	}, (*FaceSetRequest).GetDetail)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FaceSetGetDetailFaceFaceResponse), nil
+}
@@ -0,0 +1,158 @@
+package sdk
+
+import (
+	"strings"
+)
+
+/**
+ * 分页遍历 FaceSet 列表和 FaceSet 中的 face_token
+ * getfacesets 和 getdetail 接口通过 start 参数和返回的 next 分页，游标在遍历时按需请求下一页。
+ * 用法：
+ *	it := faceSDK.FaceSetIterator("tag1")
+ *	for it.Next() {
+ *		log.Println(it.FaceSet().OuterId)
+ *	}
+ *	if err := it.Err(); err != nil {
+ *		...
+ *	}
+ */
+
+// FaceSetIterator 遍历某一 API Key 下 FaceSet 列表的游标
+type FaceSetIterator struct {
+	sdk   *FaceSDK
+	tags  string
+	start string     // 下一页的起始序号，为空表示第一页
+	done  bool       // 是否已经请求过最后一页
+	page  []*Faceset // 当前页数据
+	index int        // 当前元素在page中的下标
+	err   error
+}
+
+/**
+ * FaceSetIterator 创建遍历 FaceSet 列表的游标
+ * @param tags 只返回包含这些标签的 FaceSet，不传则遍历全部
+ */
+func (sdk *FaceSDK) FaceSetIterator(tags ...string) *FaceSetIterator {
+	return &FaceSetIterator{
+		sdk:   sdk,
+		tags:  strings.Join(tags, ","),
+		index: -1,
+	}
+}
+
+// Next 移动到下一个 FaceSet，没有更多数据或出错时返回false
+func (it *FaceSetIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.index >= len(it.page) {
+		if it.done {
+			return false
+		}
+		options := make(map[string]interface{})
+		if it.tags != "" {
+			options["tags"] = it.tags
+		}
+		if it.start != "" {
+			options["start"] = it.start
+		}
+		resp, err := it.sdk.doFaceSet(options, (*FaceSetRequest).GetFaceSets)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		getFaceSetsResponse := resp.(*FaceSetGetFaceSetsFaceFaceResponse)
+		it.page = getFaceSetsResponse.Facesets
+		it.index = 0
+		it.start = getFaceSetsResponse.Next
+		it.done = getFaceSetsResponse.Next == ""
+	}
+	return true
+}
+
+// FaceSet 返回当前的 FaceSet
+func (it *FaceSetIterator) FaceSet() *Faceset {
+	if it.index < 0 || it.index >= len(it.page) {
+		return nil
+	}
+	return it.page[it.index]
+}
+
+// Err 返回遍历过程中遇到的错误
+func (it *FaceSetIterator) Err() error {
+	return it.err
+}
+
+// FaceTokenIterator 遍历一个 FaceSet 中所有 face_token 的游标
+type FaceTokenIterator struct {
+	sdk    *FaceSDK
+	set    string
+	dt     string
+	start  string
+	done   bool
+	detail *FaceSetGetDetailFaceFaceResponse // 最近一次请求的 FaceSet 详情
+	index  int
+	err    error
+}
+
+/**
+ * FaceTokenIterator 创建遍历 FaceSet 中 face_token 的游标
+ * @param set FaceSet 标识
+ * @param dt 可以是(faceset_token|outer_id)
+ */
+func (sdk *FaceSDK) FaceTokenIterator(set, dt string) *FaceTokenIterator {
+	return &FaceTokenIterator{
+		sdk:   sdk,
+		set:   set,
+		dt:    dt,
+		index: -1,
+	}
+}
+
+// Next 移动到下一个 face_token，没有更多数据或出错时返回false
+func (it *FaceTokenIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.detail == nil || it.index >= len(it.detail.FaceTokens) {
+		if it.done {
+			return false
+		}
+		options := map[string]interface{}{
+			it.dt: it.set,
+		}
+		if it.start != "" {
+			options["start"] = it.start
+		}
+		resp, err := it.sdk.doFaceSet(options, (*FaceSetRequest).GetDetail)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.detail = resp.(*FaceSetGetDetailFaceFaceResponse)
+		it.index = 0
+		it.start = it.detail.Next
+		it.done = it.detail.Next == ""
+	}
+	return true
+}
+
+// FaceToken 返回当前的 face_token
+func (it *FaceTokenIterator) FaceToken() string {
+	if it.detail == nil || it.index < 0 || it.index >= len(it.detail.FaceTokens) {
+		return ""
+	}
+	return it.detail.FaceTokens[it.index]
+}
+
+// Detail 返回最近一次请求得到的 FaceSet 详情，在第一次调用 Next 之前为nil
+func (it *FaceTokenIterator) Detail() *FaceSetGetDetailFaceFaceResponse {
+	return it.detail
+}
+
+// Err 返回遍历过程中遇到的错误
+func (it *FaceTokenIterator) Err() error {
+	return it.err
+}
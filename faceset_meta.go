@@ -0,0 +1,232 @@
+package sdk
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/**
+ * FaceSet 的 user_data 和 tags 辅助方法
+ * user_data 最大 16KB，tags 为逗号分隔的标签，最长 255 个字符，两者都不能包含字符 ^@,&=*'"。
+ * 结构化数据序列化为 JSON 后再经 base64url（无填充）编码保存到 user_data，避免 JSON 中的引号和逗号被接口拒绝。
+ */
+
+const (
+	FaceSetUserDataMaxSize = 16 * 1024 // user_data 最大字节数
+	FaceSetTagsMaxLength   = 255       // tags 最大字符数
+)
+
+// ErrUserDataTooLarge user_data 超过大小限制
+var ErrUserDataTooLarge = fmt.Errorf("user_data 不能超过%d字节", FaceSetUserDataMaxSize)
+
+// faceSetTagsMaxAttempts 修改标签时最多写入的次数
+const faceSetTagsMaxAttempts = 3
+
+// ErrFaceSetTagsConflict 多次写入后标签仍被其他写入方覆盖
+var ErrFaceSetTagsConflict = fmt.Errorf("tags 连续%d次写入后仍被其他写入方覆盖", faceSetTagsMaxAttempts)
+
+// EncodeUserData 将v序列化为JSON并进行 base64url 编码作为 user_data，并检查编码后的大小限制
+func EncodeUserData(v interface{}) (string, error) {
+	js, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	userData := base64.RawURLEncoding.EncodeToString(js)
+	if len(userData) > FaceSetUserDataMaxSize {
+		return "", ErrUserDataTooLarge
+	}
+	return userData, nil
+}
+
+// DecodeUserData 将 EncodeUserData 生成的 user_data 解码并解析到v
+func DecodeUserData(userData string, v interface{}) error {
+	if userData == "" {
+		return errors.New("user_data 为空")
+	}
+	js, err := base64.RawURLEncoding.DecodeString(userData)
+	if err != nil {
+		return fmt.Errorf("user_data 不是有效的编码数据:%s", err.Error())
+	}
+	return json.Unmarshal(js, v)
+}
+
+// DecodeUserData 将 FaceSet 详情中的 user_data 解析到v
+func (resp *FaceSetGetDetailFaceFaceResponse) DecodeUserData(v interface{}) error {
+	return DecodeUserData(resp.UserData, v)
+}
+
+// FaceSetTags FaceSet 的标签集合，保持标签的原有顺序
+type FaceSetTags []string
+
+// ParseFaceSetTags 解析逗号分隔的标签字符串，去除空白和重复标签
+func ParseFaceSetTags(tags string) FaceSetTags {
+	set := make(FaceSetTags, 0)
+	for _, tag := range strings.Split(tags, ",") {
+		set = set.Add(strings.TrimSpace(tag))
+	}
+	return set
+}
+
+// TagSet 返回 FaceSet 的标签集合
+func (f *Faceset) TagSet() FaceSetTags {
+	return ParseFaceSetTags(f.Tags)
+}
+
+// TagSet 返回 FaceSet 的标签集合
+func (resp *FaceSetGetDetailFaceFaceResponse) TagSet() FaceSetTags {
+	return ParseFaceSetTags(resp.Tags)
+}
+
+// Has 判断是否包含标签
+func (tags FaceSetTags) Has(tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Add 添加标签，已存在或为空的标签会被忽略
+func (tags FaceSetTags) Add(tag ...string) FaceSetTags {
+	for _, t := range tag {
+		if t != "" && !tags.Has(t) {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
+
+// Remove 移除标签，返回新的标签集合
+func (tags FaceSetTags) Remove(tag ...string) FaceSetTags {
+	set := make(FaceSetTags, 0, len(tags))
+	for _, t := range tags {
+		if !FaceSetTags(tag).Has(t) {
+			set = append(set, t)
+		}
+	}
+	return set
+}
+
+// String 转换为接口使用的逗号分隔字符串
+func (tags FaceSetTags) String() string {
+	return strings.Join(tags, ",")
+}
+
+// Validate 检查标签字符和长度是否符合接口要求
+func (tags FaceSetTags) Validate() error {
+	for _, t := range tags {
+		if strings.ContainsAny(t, faceSetAttrInvalidChars) {
+			return fmt.Errorf("标签 %s 不能包含字符 %s", t, faceSetAttrInvalidChars)
+		}
+	}
+	if len([]rune(tags.String())) > FaceSetTagsMaxLength {
+		return fmt.Errorf("tags 不能超过%d个字符", FaceSetTagsMaxLength)
+	}
+	return nil
+}
+
+/**
+ * SetFaceSetUserData 将v编码后保存到 FaceSet 的 user_data
+ * @param set FaceSet 标识
+ * @param dt 可以是(faceset_token|outer_id)
+ */
+func (sdk *FaceSDK) SetFaceSetUserData(set, dt string, v interface{}) error {
+	userData, err := EncodeUserData(v)
+	if err != nil {
+		return err
+	}
+	_, err = sdk.doFaceSet(map[string]interface{}{
+		dt:          set,
+		"user_data": userData,
+	}, (*FaceSetRequest).Update)
+	return err
+}
+
+/**
+ * LoadFaceSetUserData 读取 FaceSet 的 user_data 并解析到v
+ * @param set FaceSet 标识
+ * @param dt 可以是(faceset_token|outer_id)
+ */
+func (sdk *FaceSDK) LoadFaceSetUserData(set, dt string, v interface{}) error {
+	detail, err := sdk.getFaceSetDetail(set, dt)
+	if err != nil {
+		return err
+	}
+	return detail.DecodeUserData(v)
+}
+
+/**
+ * AddFaceSetTags 为 FaceSet 添加标签，返回更新后的标签集合
+ * 接口没有条件更新，写入后会重新读取确认，被其他写入方覆盖时重试，但仍不能完全避免并发写入时的丢失，
+ * 需要严格保证时应在调用方串行化对同一个 FaceSet 标签的修改
+ * @param set FaceSet 标识
+ * @param dt 可以是(faceset_token|outer_id)
+ */
+func (sdk *FaceSDK) AddFaceSetTags(set, dt string, tags ...string) (FaceSetTags, error) {
+	return sdk.updateFaceSetTags(set, dt, func(current FaceSetTags) FaceSetTags {
+		return current.Add(tags...)
+	})
+}
+
+/**
+ * RemoveFaceSetTags 移除 FaceSet 的标签，返回更新后的标签集合
+ * 并发写入时的行为同 AddFaceSetTags
+ * @param set FaceSet 标识
+ * @param dt 可以是(faceset_token|outer_id)
+ */
+func (sdk *FaceSDK) RemoveFaceSetTags(set, dt string, tags ...string) (FaceSetTags, error) {
+	return sdk.updateFaceSetTags(set, dt, func(current FaceSetTags) FaceSetTags {
+		return current.Remove(tags...)
+	})
+}
+
+/**
+ * 读取当前标签，经fn修改后写回，标签没有变化时不发起更新请求
+ * 写入后重新读取，其他写入方在读取和写入之间覆盖了标签导致修改丢失时，基于最新的标签重试
+ */
+func (sdk *FaceSDK) updateFaceSetTags(set, dt string, fn func(FaceSetTags) FaceSetTags) (FaceSetTags, error) {
+	detail, err := sdk.getFaceSetDetail(set, dt)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 0; ; attempt++ {
+		current := detail.TagSet()
+		tags := fn(append(FaceSetTags{}, current...))
+		if tags.String() == current.String() {
+			return current, nil
+		}
+		if attempt == faceSetTagsMaxAttempts {
+			return nil, ErrFaceSetTagsConflict
+		}
+		if err = tags.Validate(); err != nil {
+			return nil, err
+		}
+		_, err = sdk.doFaceSet(map[string]interface{}{
+			dt:     set,
+			"tags": tags.String(),
+		}, (*FaceSetRequest).Update)
+		if err != nil {
+			return nil, err
+		}
+		if detail, err = sdk.getFaceSetDetail(set, dt); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// GetFaceSetsByTags 获取包含指定标签的全部 FaceSet，自动处理分页
+func (sdk *FaceSDK) GetFaceSetsByTags(tags ...string) ([]*Faceset, error) {
+	facesets := make([]*Faceset, 0)
+	it := sdk.FaceSetIterator(tags...)
+	for it.Next() {
+		facesets = append(facesets, it.FaceSet())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return facesets, nil
+}
@@ -0,0 +1,106 @@
+package sdk
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEncodeUserData(t *testing.T) {
+	type meta struct {
+		Tenant  string   `json:"tenant"`
+		Purpose string   `json:"purpose"`
+		Labels  []string `json:"labels"`
+	}
+	in := meta{Tenant: "a&b", Purpose: `say "hi", ok?`, Labels: []string{"x=1", "y*2"}}
+	userData, err := EncodeUserData(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.ContainsAny(userData, "^@,&=*'\"") {
+		t.Fatalf("EncodeUserData = %q contains characters rejected by the API", userData)
+	}
+	var out meta
+	if err := DecodeUserData(userData, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Tenant != in.Tenant || out.Purpose != in.Purpose || strings.Join(out.Labels, ",") != strings.Join(in.Labels, ",") {
+		t.Fatalf("DecodeUserData = %+v, want %+v", out, in)
+	}
+}
+
+func TestEncodeUserDataSize(t *testing.T) {
+	// JSON 字符串加引号后经 base64 编码，长度约为原来的 4/3
+	tests := []struct {
+		name    string
+		size    int
+		wantErr bool
+	}{
+		{"fits after encoding", FaceSetUserDataMaxSize*3/4 - 2, false},
+		{"raw JSON fits but encoded does not", FaceSetUserDataMaxSize - 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userData, err := EncodeUserData(strings.Repeat("a", tt.size))
+			if tt.wantErr {
+				if err != ErrUserDataTooLarge {
+					t.Fatalf("err = %v, want ErrUserDataTooLarge", err)
+				}
+				return
+			}
+			if err != nil || len(userData) > FaceSetUserDataMaxSize {
+				t.Fatalf("len = %d, err = %v", len(userData), err)
+			}
+		})
+	}
+}
+
+func TestDecodeUserDataInvalid(t *testing.T) {
+	var v map[string]interface{}
+	for _, userData := range []string{"", `{"raw":"json"}`} {
+		if err := DecodeUserData(userData, &v); err == nil {
+			t.Errorf("DecodeUserData(%q) returned no error", userData)
+		}
+	}
+}
+
+func TestFaceSetTags(t *testing.T) {
+	tags := ParseFaceSetTags(" a, b ,,a,c")
+	if got := tags.String(); got != "a,b,c" {
+		t.Fatalf("ParseFaceSetTags = %q, want %q", got, "a,b,c")
+	}
+	if got := tags.Add("b", "d", "").String(); got != "a,b,c,d" {
+		t.Fatalf("Add = %q, want %q", got, "a,b,c,d")
+	}
+	if got := tags.Remove("a", "x").String(); got != "b,c" {
+		t.Fatalf("Remove = %q, want %q", got, "b,c")
+	}
+	if got := tags.String(); got != "a,b,c" {
+		t.Fatalf("Remove modified the original set: %q", got)
+	}
+	if !tags.Has("c") || tags.Has("d") {
+		t.Fatalf("Has mismatch for %q", tags)
+	}
+}
+
+func TestFaceSetTagsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    FaceSetTags
+		wantErr bool
+	}{
+		{"valid", FaceSetTags{"tenant-1", "purpose_login"}, false},
+		{"empty", FaceSetTags{}, false},
+		{"invalid character", FaceSetTags{"a=b"}, true},
+		{"quote", FaceSetTags{`a"b`}, true},
+		{"at limit", FaceSetTags{strings.Repeat("a", 127), strings.Repeat("b", 127)}, false},
+		{"over limit", FaceSetTags{strings.Repeat("a", 128), strings.Repeat("b", 127)}, true},
+		{"limit counts characters", FaceSetTags{strings.Repeat("人", FaceSetTagsMaxLength)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.tags.Validate(); (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
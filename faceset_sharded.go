@@ -0,0 +1,411 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/**
+ * 分片 FaceSet，突破单个 FaceSet 最多存储 1,000 个 face_token 的限制
+ * 以 outer_id 前缀自动创建编号的 FaceSet 作为分片，例如 users_0、users_1 ...
+ * 新的人脸加入当前最空的分片，分片已满（QUOTA_EXCEEDED）时自动转入其他分片或创建新分片；
+ * 搜索时并发搜索所有分片，空分片（EMPTY_FACESET）视为没有结果，按置信度合并结果。
+ * 分片列表和 face_token 数量缓存在本地，其他进程在 Load 之后创建或修改的分片需要再次调用 Load 才能被发现。
+ */
+
+// FaceSetMaxFaceCount 一个 FaceSet 最多能存储的 face_token 数量
+const FaceSetMaxFaceCount = 1000
+
+const (
+	FailureReasonQuotaExceeded = "QUOTA_EXCEEDED" // 已达到 FaceSet 存储上限
+	FailureReasonNoShard       = "NO_SHARD"       // 还没有任何分片，face_token 无法移除，由 SDK 生成
+)
+
+// FaceSetShard 分片信息
+type FaceSetShard struct {
+	Index        int    // 分片编号
+	OuterId      string // 分片的 outer_id
+	FacesetToken string // 分片的 faceset_token
+	FaceCount    int    // 分片中的 face_token 数量
+	full         bool   // 是否已经返回过 QUOTA_EXCEEDED
+}
+
+// ShardedFaceSet 分片 FaceSet 管理对象
+type ShardedFaceSet struct {
+	Prefix      string        // 分片 outer_id 前缀
+	Capacity    int           // 每个分片最多存储的 face_token 数量，为0时使用 FaceSetMaxFaceCount
+	Attrs       *FaceSetAttrs // 创建分片时使用的属性，可以为nil
+	Concurrency int           // 并发请求数，小于等于0时使用默认值
+
+	sdk    *FaceSDK
+	mu     sync.Mutex
+	loaded bool
+	shards []*FaceSetShard
+}
+
+// ShardedFaceSetAddResult 分片 FaceSet 添加人脸结果
+type ShardedFaceSetAddResult struct {
+	FaceAdded     int               // 本次成功加入的 face_token 数量
+	FaceCount     int               // 操作结束后所有分片中的 face_token 总数量
+	FailureDetail []*FailureDetail  // 无法被加入的 face_token 以及原因
+	Placement     map[string]string // 成功加入的 face_token 所在分片的 outer_id
+}
+
+// ShardedFaceSetRemoveResult 分片 FaceSet 移除人脸结果
+type ShardedFaceSetRemoveResult struct {
+	FaceRemoved   int              // 本次成功移除的 face_token 数量
+	FaceCount     int              // 操作结束后所有分片中的 face_token 总数量
+	FailureDetail []*FailureDetail // 在发送到的所有分片中都无法移除的 face_token 以及原因
+}
+
+// ShardedFaceSet 创建分片 FaceSet 管理对象
+func (sdk *FaceSDK) ShardedFaceSet(prefix string, attrs ...*FaceSetAttrs) *ShardedFaceSet {
+	sfs := &ShardedFaceSet{
+		Prefix: prefix,
+		sdk:    sdk,
+	}
+	if len(attrs) > 0 {
+		sfs.Attrs = attrs[0]
+	}
+	return sfs
+}
+
+// Load 重新读取已存在的分片及其 face_token 数量
+func (sfs *ShardedFaceSet) Load(ctx context.Context) error {
+	sfs.mu.Lock()
+	defer sfs.mu.Unlock()
+	return sfs.load(ctx)
+}
+
+// Shards 返回当前的分片信息
+func (sfs *ShardedFaceSet) Shards(ctx context.Context) ([]FaceSetShard, error) {
+	sfs.mu.Lock()
+	defer sfs.mu.Unlock()
+	if err := sfs.ensureLoaded(ctx); err != nil {
+		return nil, err
+	}
+	shards := make([]FaceSetShard, len(sfs.shards))
+	for i, shard := range sfs.shards {
+		shards[i] = *shard
+	}
+	return shards, nil
+}
+
+// AddFaces 添加 face_token，自动选择分片
+func (sfs *ShardedFaceSet) AddFaces(ctx context.Context, faceTokens []string) (*ShardedFaceSetAddResult, error) {
+	sfs.mu.Lock()
+	defer sfs.mu.Unlock()
+	result := &ShardedFaceSetAddResult{
+		FailureDetail: make([]*FailureDetail, 0),
+		Placement:     make(map[string]string),
+	}
+	if err := sfs.ensureLoaded(ctx); err != nil {
+		return result, err
+	}
+
+	pending := append([]string{}, faceTokens...)
+	for len(pending) > 0 {
+		shard, err := sfs.leastFullShard(ctx)
+		if err != nil {
+			result.FaceCount = sfs.faceCount()
+			return result, err
+		}
+		n := sfs.capacity() - shard.FaceCount
+		if n > len(pending) {
+			n = len(pending)
+		}
+		batch := pending[:n]
+		pending = pending[n:]
+
+		resp, err := sfs.sdk.AddFaces(ctx, shard.OuterId, "outer_id", batch, sfs.Concurrency)
+		if resp.FacesetToken != "" {
+			shard.FacesetToken = resp.FacesetToken
+			shard.FaceCount = resp.FaceCount
+		}
+		result.FaceAdded += resp.FaceAdded
+		failed := make(map[string]bool)
+		for _, detail := range resp.FailureDetail {
+			failed[detail.FaceToken] = true
+			if detail.Reason == FailureReasonQuotaExceeded {
+				// 分片已满，放入其他分片
+				shard.full = true
+				pending = append(pending, detail.FaceToken)
+			} else {
+				result.FailureDetail = append(result.FailureDetail, detail)
+			}
+		}
+		if err != nil {
+			result.FaceCount = sfs.faceCount()
+			return result, err
+		}
+		for _, faceToken := range batch {
+			if !failed[faceToken] {
+				result.Placement[faceToken] = shard.OuterId
+			}
+		}
+	}
+	result.FaceCount = sfs.faceCount()
+	return result, nil
+}
+
+/**
+ * RemoveFaces 从分片中移除 face_token，各分片并发执行
+ * 在placement中能找到所在分片的 face_token 只发送到该分片，其他 face_token 发送到所有分片
+ * @param faceTokens 要移除的 face_token 数组
+ * @param placement 可选，face_token 所在分片的 outer_id，通常来自 AddFaces 返回的 Placement
+ */
+func (sfs *ShardedFaceSet) RemoveFaces(ctx context.Context, faceTokens []string, placement ...map[string]string) (*ShardedFaceSetRemoveResult, error) {
+	sfs.mu.Lock()
+	defer sfs.mu.Unlock()
+	result := &ShardedFaceSetRemoveResult{
+		FailureDetail: make([]*FailureDetail, 0),
+	}
+	if err := sfs.ensureLoaded(ctx); err != nil {
+		return result, err
+	}
+
+	// 按分片分组，targets 记录每个 face_token 发送到的分片数量
+	shardIndex := make(map[string]int, len(sfs.shards))
+	for i, shard := range sfs.shards {
+		shardIndex[shard.OuterId] = i
+	}
+	perShard := make([][]string, len(sfs.shards))
+	targets := make(map[string]int, len(faceTokens))
+	unplaced := make([]string, 0)
+	for _, faceToken := range faceTokens {
+		if i, ok := shardIndex[lookupPlacement(placement, faceToken)]; ok {
+			perShard[i] = append(perShard[i], faceToken)
+			targets[faceToken] = 1
+			continue
+		}
+		unplaced = append(unplaced, faceToken)
+		targets[faceToken] = len(sfs.shards)
+	}
+	shards := make([]*FaceSetShard, 0, len(sfs.shards))
+	tokens := make([][]string, 0, len(sfs.shards))
+	for i, shard := range sfs.shards {
+		if list := append(perShard[i], unplaced...); len(list) > 0 {
+			shards = append(shards, shard)
+			tokens = append(tokens, list)
+		}
+	}
+
+	responses := make([]*FaceSetRemoveFaceFaceResponse, len(shards))
+	// 分片之间已经并发，单个分片内的分组依次执行，总并发数不超过 Concurrency
+	err := parallel(ctx, len(shards), sfs.Concurrency, func(i int) error {
+		resp, err := sfs.sdk.RemoveFaces(ctx, shards[i].OuterId, "outer_id", tokens[i], 1)
+		responses[i] = resp
+		if resp.FacesetToken != "" {
+			shards[i].FaceCount = resp.FaceCount
+			shards[i].full = false
+		}
+		return err
+	})
+	for _, resp := range responses {
+		if resp != nil {
+			result.FaceRemoved += resp.FaceRemoved
+		}
+	}
+	if err != nil {
+		result.FaceCount = sfs.faceCount()
+		return result, err
+	}
+
+	// face_token 只存在于一个分片中，只有在发送到的所有分片中都移除失败才认为失败
+	failures := make(map[string]int)
+	for _, resp := range responses {
+		for _, detail := range resp.FailureDetail {
+			failures[detail.FaceToken]++
+			if failures[detail.FaceToken] == targets[detail.FaceToken] {
+				result.FailureDetail = append(result.FailureDetail, detail)
+			}
+		}
+	}
+	// 没有任何分片时无法移除
+	for _, faceToken := range faceTokens {
+		if targets[faceToken] == 0 {
+			result.FailureDetail = append(result.FailureDetail, &FailureDetail{
+				Reason:    FailureReasonNoShard,
+				FaceToken: faceToken,
+			})
+		}
+	}
+	result.FaceCount = sfs.faceCount()
+	return result, nil
+}
+
+// 在多个placement中查找 face_token 所在分片的 outer_id，找不到时返回空字符串
+func lookupPlacement(placement []map[string]string, faceToken string) string {
+	for _, p := range placement {
+		if outerId, ok := p[faceToken]; ok {
+			return outerId
+		}
+	}
+	return ""
+}
+
+/**
+ * Search 在所有分片中搜索人脸，合并后按置信度返回前topK个结果
+ * 使用图片搜索时只检测一次图片，之后使用最大人脸的 face_token 搜索各个分片
+ * 只搜索本地已知的分片，其他进程在 Load 之后创建的分片需要先调用 Load 才会被搜索
+ * @param face 要搜索的人脸
+ * @param dt 可以是(face_token|image_url|image_file|image_base64)
+ * @param topK 返回的结果数量，小于等于0时返回全部结果
+ */
+func (sfs *ShardedFaceSet) Search(ctx context.Context, face, dt string, topK int) (*SearchFaceResponse, error) {
+	sfs.mu.Lock()
+	if err := sfs.ensureLoaded(ctx); err != nil {
+		sfs.mu.Unlock()
+		return nil, err
+	}
+	sets := make([]*Faceset, len(sfs.shards))
+	for i, shard := range sfs.shards {
+		sets[i] = &Faceset{
+			FacesetToken: shard.FacesetToken,
+			OuterId:      shard.OuterId,
+		}
+	}
+	sfs.mu.Unlock()
+
+	faceToken, dr, err := sfs.sdk.probeFace(face, dt)
+	if err != nil {
+		return nil, err
+	}
+	perSet := topK
+	if perSet <= 0 {
+		perSet = SearchMaxResultCount
+	}
+	responses, err := sfs.sdk.searchFaceSets(ctx, faceToken, sets, perSet, sfs.Concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	searchFaceResponse := new(SearchFaceResponse)
+	searchFaceResponse.far = sfs.sdk.DefaultFAR
+	searchFaceResponse.Results = mergeSearchResults(responses, topK)
+	for _, sr := range responses {
+		if sr.Thresholds != nil {
+			searchFaceResponse.Thresholds = sr.Thresholds
+			break
+		}
+	}
+	if dr != nil {
+		searchFaceResponse.RequestId = dr.RequestId
+		searchFaceResponse.ImageId = dr.ImageId
+		searchFaceResponse.Faces = dr.Faces
+	}
+	return searchFaceResponse, nil
+}
+
+// 分片的 outer_id
+func (sfs *ShardedFaceSet) shardOuterId(index int) string {
+	return fmt.Sprintf("%s_%d", sfs.Prefix, index)
+}
+
+// 每个分片的容量
+func (sfs *ShardedFaceSet) capacity() int {
+	if sfs.Capacity <= 0 || sfs.Capacity > FaceSetMaxFaceCount {
+		return FaceSetMaxFaceCount
+	}
+	return sfs.Capacity
+}
+
+// 所有分片的 face_token 总数量
+func (sfs *ShardedFaceSet) faceCount() int {
+	count := 0
+	for _, shard := range sfs.shards {
+		count += shard.FaceCount
+	}
+	return count
+}
+
+func (sfs *ShardedFaceSet) ensureLoaded(ctx context.Context) error {
+	if sfs.loaded {
+		return nil
+	}
+	return sfs.load(ctx)
+}
+
+// 遍历 FaceSet 列表找出所有分片，并获取每个分片的 face_token 数量
+func (sfs *ShardedFaceSet) load(ctx context.Context) error {
+	shards := make([]*FaceSetShard, 0)
+	it := sfs.sdk.FaceSetIterator()
+	for it.Next() {
+		faceset := it.FaceSet()
+		suffix := strings.TrimPrefix(faceset.OuterId, sfs.Prefix+"_")
+		if suffix == faceset.OuterId {
+			continue
+		}
+		index, err := strconv.Atoi(suffix)
+		if err != nil || index < 0 || sfs.shardOuterId(index) != faceset.OuterId {
+			continue
+		}
+		shards = append(shards, &FaceSetShard{
+			Index:        index,
+			OuterId:      faceset.OuterId,
+			FacesetToken: faceset.FacesetToken,
+		})
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	sort.Slice(shards, func(i, j int) bool {
+		return shards[i].Index < shards[j].Index
+	})
+
+	err := parallel(ctx, len(shards), sfs.Concurrency, func(i int) error {
+		detail, err := sfs.sdk.getFaceSetDetail(shards[i].OuterId, "outer_id")
+		if err != nil {
+			return err
+		}
+		shards[i].FaceCount = detail.FaceCount
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sfs.shards = shards
+	sfs.loaded = true
+	return nil
+}
+
+// 返回未满且 face_token 数量最少的分片，所有分片都满时创建新分片
+func (sfs *ShardedFaceSet) leastFullShard(ctx context.Context) (*FaceSetShard, error) {
+	var least *FaceSetShard
+	for _, shard := range sfs.shards {
+		if shard.full || shard.FaceCount >= sfs.capacity() {
+			continue
+		}
+		if least == nil || shard.FaceCount < least.FaceCount {
+			least = shard
+		}
+	}
+	if least != nil {
+		return least, nil
+	}
+
+	index := 0
+	if len(sfs.shards) > 0 {
+		index = sfs.shards[len(sfs.shards)-1].Index + 1
+	}
+	detail, err := sfs.sdk.EnsureFaceSet(ctx, sfs.shardOuterId(index), sfs.Attrs)
+	if err != nil {
+		return nil, err
+	}
+	shard := &FaceSetShard{
+		Index:        index,
+		OuterId:      detail.OuterId,
+		FacesetToken: detail.FacesetToken,
+		FaceCount:    detail.FaceCount,
+	}
+	sfs.shards = append(sfs.shards, shard)
+	if shard.FaceCount >= sfs.capacity() {
+		// 其他进程已经创建并写满了该分片，继续寻找
+		return sfs.leastFullShard(ctx)
+	}
+	return shard, nil
+}
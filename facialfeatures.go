@@ -0,0 +1,155 @@
+package sdk
+
+/**
+ * 面部特征分析
+ * 对图片中最大的人脸进行五官特征分析，返回脸型、眉型、眼型、鼻型、嘴型和下巴的分类结果，
+ * 以及三庭、五眼、黄金三角等比例测量值。
+ */
+
+const facialFeaturesAPIURL = APIHost + "/facepp/v1/facialfeatures"
+
+// FacialFeaturesThreeParts 三庭
+type FacialFeaturesThreeParts struct {
+	PartsRatio string `json:"parts_ratio"` // 三庭比例
+	OnePart    struct {
+		FaceupLength float64 `json:"faceup_length"` // 上庭长度
+		FaceupRatio  float64 `json:"faceup_ratio"`  // 上庭占比
+		FaceupResult string  `json:"faceup_result"` // 上庭判断结果
+	} `json:"one_part"` // 上庭
+	TwoPart struct {
+		FacemidLength float64 `json:"facemid_length"` // 中庭长度
+		FacemidRatio  float64 `json:"facemid_ratio"`  // 中庭占比
+		FacemidResult string  `json:"facemid_result"` // 中庭判断结果
+	} `json:"two_part"` // 中庭
+	ThreePart struct {
+		FacedownLength float64 `json:"facedown_length"` // 下庭长度
+		FacedownRatio  float64 `json:"facedown_ratio"`  // 下庭占比
+		FacedownResult string  `json:"facedown_result"` // 下庭判断结果
+	} `json:"three_part"` // 下庭
+}
+
+// FacialFeaturesFiveEyes 五眼
+type FacialFeaturesFiveEyes struct {
+	EyesRatio float64 `json:"eyes_ratio"` // 眼睛宽度与两眼间距的比例
+	OneEye    struct {
+		RighteyeEmptyLength float64 `json:"righteye_empty_length"` // 右眼外侧到脸部轮廓的距离
+		RighteyeEmptyResult string  `json:"righteye_empty_result"` // 判断结果
+	} `json:"one_eye"` // 第一眼，右侧留白
+	Righteye float64 `json:"righteye"` // 右眼宽度
+	ThreeEye struct {
+		EyeinLength float64 `json:"eyein_length"` // 两眼内眼角间距
+		EyeinResult string  `json:"eyein_result"` // 判断结果
+	} `json:"three_eye"` // 第三眼，两眼间距
+	Lefteye float64 `json:"lefteye"` // 左眼宽度
+	FiveEye struct {
+		LefteyeEmptyLength float64 `json:"lefteye_empty_length"` // 左眼外侧到脸部轮廓的距离
+		LefteyeEmptyResult string  `json:"lefteye_empty_result"` // 判断结果
+	} `json:"five_eye"` // 第五眼，左侧留白
+}
+
+// FacialFeaturesResult 面部特征分析结果
+type FacialFeaturesResult struct {
+	ThreeParts     FacialFeaturesThreeParts `json:"three_parts"`     // 三庭
+	FiveEyes       FacialFeaturesFiveEyes   `json:"five_eyes"`       // 五眼
+	GoldenTriangle float64                  `json:"golden_triangle"` // 黄金三角角度
+	Face           struct {
+		FaceType       string  `json:"face_type"`       // 脸型 pointed_face 瓜子脸 oval_face 椭圆脸 diamond_face 菱形脸 round_face 圆形脸 long_face 长形脸 square_face 方形脸 normal_face 标准脸
+		TempusLength   float64 `json:"tempus_length"`   // 颞部宽度
+		ZygomaLength   float64 `json:"zygoma_length"`   // 颧骨宽度
+		MandibleLength float64 `json:"mandible_length"` // 下颌角宽度
+		E              float64 `json:"E"`               // 下颌角度数
+		ABDRatio       float64 `json:"ABD_ratio"`       // 颞部宽度、颧骨宽度、下颌角宽度的比例
+	} `json:"face"` // 脸型
+	Jaw struct {
+		JawType   string  `json:"jaw_type"`   // 下巴类型 flat_jaw 圆下巴 sharp_jaw 尖下巴 square_jaw 方下巴
+		JawWidth  float64 `json:"jaw_width"`  // 下巴宽度
+		JawLength float64 `json:"jaw_length"` // 下巴长度
+		JawAngle  float64 `json:"jaw_angle"`  // 下巴角度
+	} `json:"jaw"` // 下巴
+	Eyebrow struct {
+		EyebrowType      string  `json:"eyebrow_type"`       // 眉型 bushy_eyebrows 粗眉 eight_eyebrows 八字眉 raised_eyebrows 上挑眉 straight_eyebrows 一字眉 round_eyebrows 拱形眉 arch_eyebrows 柳叶眉 thin_eyebrows 细眉
+		BrowWidth        float64 `json:"brow_width"`         // 眉毛宽度
+		BrowHeight       float64 `json:"brow_height"`        // 眉毛高度
+		BrowUptrendAngle float64 `json:"brow_uptrend_angle"` // 眉毛挑度
+		BrowCamberAngle  float64 `json:"brow_camber_angle"`  // 眉毛弯度
+		BrowThick        float64 `json:"brow_thick"`         // 眉毛粗细
+	} `json:"eyebrow"` // 眉毛
+	Eyes struct {
+		EyesType             string  `json:"eyes_type"`              // 眼型 round_eyes 圆眼 thin_eyes 细长眼 big_eyes 大眼 small_eyes 小眼 normal_eyes 标准眼
+		EyeWidth             float64 `json:"eye_width"`              // 眼睛宽度
+		EyeHeight            float64 `json:"eye_height"`             // 眼睛高度
+		AngulusOculiMedialis float64 `json:"angulus_oculi_medialis"` // 内眦角度
+	} `json:"eyes"` // 眼睛
+	Nose struct {
+		NoseType  string  `json:"nose_type"`  // 鼻型 normal_nose 标准鼻 thick_nose 宽鼻 thin_nose 窄鼻
+		NoseWidth float64 `json:"nose_width"` // 鼻翼宽度
+	} `json:"nose"` // 鼻子
+	Mouth struct {
+		MouthType    string  `json:"mouth_type"`    // 唇型 thin_lip 薄唇 thick_lip 厚唇 smile_lip 微笑唇 upset_lip 态度唇 normal_lip 标准唇
+		MouthHeight  float64 `json:"mouth_height"`  // 嘴巴高度
+		MouthWidth   float64 `json:"mouth_width"`   // 嘴巴宽度
+		LipThickness float64 `json:"lip_thickness"` // 嘴唇厚度
+		AngulusOris  float64 `json:"angulus_oris"`  // 嘴角弯曲度
+	} `json:"mouth"` // 嘴巴
+}
+
+// FacialFeaturesFaceResponse 面部特征分析响应数据
+type FacialFeaturesFaceResponse struct {
+	FaceResponse
+	FaceRectangle FaceRectangle        `json:"face_rectangle"` // 被分析的人脸框位置
+	ImageReset    Base64Image          `json:"image_reset"`    // 矫正后的人脸图片，需要设置 return_imagereset 为1
+	Result        FacialFeaturesResult `json:"result"`         // 面部特征分析结果
+}
+
+// FacialFeaturesRequest 面部特征分析对象
+type FacialFeaturesRequest struct {
+	FaceRequest
+}
+
+// FacialFeatures 构建一个面部特征分析对象
+func (sdk *FaceSDK) FacialFeatures(options ...map[string]interface{}) (*FacialFeaturesRequest, error) {
+	facialFeaturesRequest := new(FacialFeaturesRequest)
+	facialFeaturesRequest.FaceRequest = sdk.newFaceRequest(facialFeaturesAPIURL, options)
+	return facialFeaturesRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (ffr *FacialFeaturesRequest) SetImage(img, dt string) *FacialFeaturesRequest {
+	ffr.setImage(img, dt)
+	return ffr
+}
+
+// SetReturnImageReset 设置是否返回矫正后的人脸图片
+func (ffr *FacialFeaturesRequest) SetReturnImageReset(returnImageReset bool) *FacialFeaturesRequest {
+	if returnImageReset {
+		ffr.options["return_imagereset"] = 1
+	} else {
+		ffr.options["return_imagereset"] = 0
+	}
+	return ffr
+}
+
+// SetOption 设置请求参数
+func (ffr *FacialFeaturesRequest) SetOption(key string, val interface{}) *FacialFeaturesRequest {
+	ffr.options[key] = val
+	return ffr
+}
+
+// SetOptionMap 通过map设置请求参数
+func (ffr *FacialFeaturesRequest) SetOptionMap(options map[string]interface{}) *FacialFeaturesRequest {
+	for key, val := range options {
+		ffr.options[key] = val
+	}
+	return ffr
+}
+
+// End 发送请求获取结果
+func (ffr *FacialFeaturesRequest) End() (*FacialFeaturesFaceResponse, string, error) {
+	facialFeaturesFaceResponse := new(FacialFeaturesFaceResponse)
+	body, err := ffr.end(facialFeaturesFaceResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return facialFeaturesFaceResponse, body, nil
+}
@@ -0,0 +1,112 @@
+package sdk
+
+import (
+	"strings"
+)
+
+/**
+ * 人体检测
+ * 检测图片中的人体，返回人体矩形框、置信度，并可以分析性别、上身和下身衣服颜色等属性。
+ */
+
+const (
+	humanBodyBaseURL      = APIHost + "/humanbodypp"
+	humanBodyDetectAPIURL = humanBodyBaseURL + "/v1/detect"
+)
+
+// Rectangle 矩形框位置，与人脸框格式相同
+type Rectangle = FaceRectangle
+
+// RGB 颜色值
+type RGB struct {
+	R int `json:"r"`
+	G int `json:"g"`
+	B int `json:"b"`
+}
+
+// HumanBodyAttributes 人体属性
+type HumanBodyAttributes struct {
+	Gender struct {
+		Male   float32 `json:"male"`   // 男性置信度，范围[0,100]
+		Female float32 `json:"female"` // 女性置信度，范围[0,100]
+	} `json:"gender"` // 性别
+	UpperBodyCloth struct {
+		UpperBodyClothColor    string `json:"upper_body_cloth_color"`     // 上身衣服颜色 black|white|red|green|blue|yellow|magenta|cyan|gray|purple|orange|brown
+		UpperBodyClothColorRGB RGB    `json:"upper_body_cloth_color_rgb"` // 上身衣服颜色RGB值
+	} `json:"upper_body_cloth"` // 上身衣服
+	LowerBodyCloth struct {
+		LowerBodyClothColor    string `json:"lower_body_cloth_color"`     // 下身衣服颜色，取值同上身衣服颜色
+		LowerBodyClothColorRGB RGB    `json:"lower_body_cloth_color_rgb"` // 下身衣服颜色RGB值
+	} `json:"lower_body_cloth"` // 下身衣服
+}
+
+// GenderValue 返回置信度较高的性别 Male男|Female女
+func (hba *HumanBodyAttributes) GenderValue() string {
+	if hba.Gender.Male >= hba.Gender.Female {
+		return "Male"
+	}
+	return "Female"
+}
+
+// HumanBody 检测出的人体
+type HumanBody struct {
+	Confidence         float32              `json:"confidence"`          // 人体检测的置信度，范围[0,100]
+	HumanbodyRectangle Rectangle            `json:"humanbody_rectangle"` // 人体矩形框的位置
+	Attributes         *HumanBodyAttributes `json:"attributes"`          // 人体属性，需要设置 return_attributes
+}
+
+// DetectHumanBodyResponse 人体检测响应数据
+type DetectHumanBodyResponse struct {
+	FaceResponse
+	ImageId     string       `json:"image_id"`    // 被检测的图片在系统中的标识
+	Humanbodies []*HumanBody `json:"humanbodies"` // 被检测出的人体数组，没有检测出人体时为空数组
+}
+
+// HumanBodyDetectRequest 人体检测对象
+type HumanBodyDetectRequest struct {
+	FaceRequest
+}
+
+// HumanBodyDetect 构建一个人体检测对象
+func (sdk *FaceSDK) HumanBodyDetect(options ...map[string]interface{}) (*HumanBodyDetectRequest, error) {
+	humanBodyDetectRequest := new(HumanBodyDetectRequest)
+	humanBodyDetectRequest.FaceRequest = sdk.newFaceRequest(humanBodyDetectAPIURL, options)
+	return humanBodyDetectRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (hbdr *HumanBodyDetectRequest) SetImage(img, dt string) *HumanBodyDetectRequest {
+	hbdr.setImage(img, dt)
+	return hbdr
+}
+
+// SetReturnAttributes 设置要分析的人体属性，可以是(gender|upper_body_cloth|lower_body_cloth)
+func (hbdr *HumanBodyDetectRequest) SetReturnAttributes(attributes ...string) *HumanBodyDetectRequest {
+	hbdr.options["return_attributes"] = strings.Join(attributes, ",")
+	return hbdr
+}
+
+// SetOption 设置请求参数
+func (hbdr *HumanBodyDetectRequest) SetOption(key string, val interface{}) *HumanBodyDetectRequest {
+	hbdr.options[key] = val
+	return hbdr
+}
+
+// SetOptionMap 通过map设置请求参数
+func (hbdr *HumanBodyDetectRequest) SetOptionMap(options map[string]interface{}) *HumanBodyDetectRequest {
+	for key, val := range options {
+		hbdr.options[key] = val
+	}
+	return hbdr
+}
+
+// End 发送请求获取结果
+func (hbdr *HumanBodyDetectRequest) End() (*DetectHumanBodyResponse, string, error) {
+	detectHumanBodyResponse := new(DetectHumanBodyResponse)
+	body, err := hbdr.end(detectHumanBodyResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return detectHumanBodyResponse, body, nil
+}
@@ -0,0 +1,123 @@
+package sdk
+
+/**
+ * 手势识别
+ * 检测图片中的手部，返回每只手的矩形框以及各种手势的置信度。
+ */
+
+const humanBodyGestureAPIURL = humanBodyBaseURL + "/v1/gesture"
+
+// GestureLabel 手势名称
+type GestureLabel string
+
+const (
+	GestureUnknown        GestureLabel = "unknown"          // 未定义手势
+	GestureHeartA         GestureLabel = "heart_a"          // 比心 A
+	GestureHeartB         GestureLabel = "heart_b"          // 比心 B
+	GestureHeartC         GestureLabel = "heart_c"          // 比心 C
+	GestureHeartD         GestureLabel = "heart_d"          // 比心 D
+	GestureOK             GestureLabel = "ok"               // OK
+	GestureHandOpen       GestureLabel = "hand_open"        // 手张开
+	GestureThumbUp        GestureLabel = "thumb_up"         // 大拇指向上
+	GestureThumbDown      GestureLabel = "thumb_down"       // 大拇指向下
+	GestureRock           GestureLabel = "rock"             // ROCK
+	GestureNamaste        GestureLabel = "namaste"          // 合十
+	GesturePalmUp         GestureLabel = "palm_up"          // 手心向上
+	GestureFist           GestureLabel = "fist"             // 握拳
+	GestureIndexFingerUp  GestureLabel = "index_finger_up"  // 食指朝上
+	GestureDoubleFingerUp GestureLabel = "double_finger_up" // 双指朝上
+	GestureVictory        GestureLabel = "victory"          // 胜利
+	GestureBigV           GestureLabel = "big_v"            // 手势 V
+	GesturePhonecall      GestureLabel = "phonecall"        // 打电话
+	GestureBeg            GestureLabel = "beg"              // 作揖
+	GestureThanks         GestureLabel = "thanks"           // 感谢
+)
+
+// Hand 检测出的手
+type Hand struct {
+	HandRectangle Rectangle                `json:"hand_rectangle"` // 手部矩形框的位置
+	Gesture       map[GestureLabel]float32 `json:"gesture"`        // 每种手势的置信度，范围[0,100]
+}
+
+// Best 返回置信度最高的手势，置信度相同时按名称排序取第一个，没有结果时返回 GestureUnknown
+func (h *Hand) Best() (label GestureLabel, confidence float32) {
+	found := false
+	for l, c := range h.Gesture {
+		if !found || c > confidence || (c == confidence && l < label) {
+			label, confidence, found = l, c, true
+		}
+	}
+	if !found {
+		return GestureUnknown, 0
+	}
+	return label, confidence
+}
+
+// Is 判断手势是否为label，且是置信度最高的手势，置信度不低于minConfidence
+func (h *Hand) Is(label GestureLabel, minConfidence float32) bool {
+	best, confidence := h.Best()
+	return best == label && confidence >= minConfidence
+}
+
+// GestureHumanBodyResponse 手势识别响应数据
+type GestureHumanBodyResponse struct {
+	FaceResponse
+	ImageId string  `json:"image_id"` // 被检测的图片在系统中的标识
+	Hands   []*Hand `json:"hands"`    // 检测出的手部数组
+}
+
+// Has 判断是否有任意一只手的手势为label
+func (resp *GestureHumanBodyResponse) Has(label GestureLabel, minConfidence float32) bool {
+	for _, hand := range resp.Hands {
+		if hand.Is(label, minConfidence) {
+			return true
+		}
+	}
+	return false
+}
+
+// HumanBodyGestureRequest 手势识别对象
+type HumanBodyGestureRequest struct {
+	FaceRequest
+}
+
+// HumanBodyGesture 构建一个手势识别对象，默认返回手势置信度
+func (sdk *FaceSDK) HumanBodyGesture(options ...map[string]interface{}) (*HumanBodyGestureRequest, error) {
+	humanBodyGestureRequest := new(HumanBodyGestureRequest)
+	humanBodyGestureRequest.FaceRequest = sdk.newFaceRequest(humanBodyGestureAPIURL, options)
+	if _, ok := humanBodyGestureRequest.options["return_gesture"]; !ok {
+		humanBodyGestureRequest.options["return_gesture"] = 1
+	}
+	return humanBodyGestureRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (hbgr *HumanBodyGestureRequest) SetImage(img, dt string) *HumanBodyGestureRequest {
+	hbgr.setImage(img, dt)
+	return hbgr
+}
+
+// SetOption 设置请求参数
+func (hbgr *HumanBodyGestureRequest) SetOption(key string, val interface{}) *HumanBodyGestureRequest {
+	hbgr.options[key] = val
+	return hbgr
+}
+
+// SetOptionMap 通过map设置请求参数
+func (hbgr *HumanBodyGestureRequest) SetOptionMap(options map[string]interface{}) *HumanBodyGestureRequest {
+	for key, val := range options {
+		hbgr.options[key] = val
+	}
+	return hbgr
+}
+
+// End 发送请求获取结果
+func (hbgr *HumanBodyGestureRequest) End() (*GestureHumanBodyResponse, string, error) {
+	gestureHumanBodyResponse := new(GestureHumanBodyResponse)
+	body, err := hbgr.end(gestureHumanBodyResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return gestureHumanBodyResponse, body, nil
+}
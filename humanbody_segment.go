@@ -0,0 +1,141 @@
+package sdk
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+/**
+ * 人体抠像
+ * 识别图片中的人体轮廓，返回与原图大小相同的灰度图（alpha 蒙版），像素值越大表示越可能属于人体，
+ * 同时可以返回去除背景后的人像图片。结果可以转换为蒙版并合成到新的背景上。
+ */
+
+const humanBodySegmentAPIURL = humanBodyBaseURL + "/v2/segment"
+
+const (
+	SegmentReturnBodyImage = 0 // 只返回人像图片
+	SegmentReturnAll       = 1 // 返回灰度图和人像图片
+	SegmentReturnGrayscale = 2 // 只返回灰度图
+)
+
+// SegmentHumanBodyResponse 人体抠像响应数据
+type SegmentHumanBodyResponse struct {
+	FaceResponse
+	Result    Base64Image `json:"result"`     // 与原图大小相同的灰度图，jpg格式的base64编码
+	BodyImage Base64Image `json:"body_image"` // 去除背景后的人像图片，png格式的base64编码
+}
+
+// GrayMask 将灰度图解码为 *image.Gray，与原图对齐
+func (resp *SegmentHumanBodyResponse) GrayMask() (*image.Gray, error) {
+	if resp.Result == "" {
+		return nil, errors.New("接口没有返回灰度图，请设置 return_grayscale")
+	}
+	img, _, err := resp.Result.Decode()
+	if err != nil {
+		return nil, err
+	}
+	if gray, ok := img.(*image.Gray); ok {
+		return gray, nil
+	}
+	bounds := img.Bounds()
+	gray := image.NewGray(bounds)
+	draw.Draw(gray, bounds, img, bounds.Min, draw.Src)
+	return gray, nil
+}
+
+// Mask 将灰度图转换为 *image.Alpha 蒙版，像素值越大越可能属于人体
+func (resp *SegmentHumanBodyResponse) Mask() (*image.Alpha, error) {
+	gray, err := resp.GrayMask()
+	if err != nil {
+		return nil, err
+	}
+	alpha := &image.Alpha{
+		Pix:    gray.Pix,
+		Stride: gray.Stride,
+		Rect:   gray.Rect,
+	}
+	return alpha, nil
+}
+
+// Cutout 使用蒙版从原图src中抠出人像，背景为透明
+func (resp *SegmentHumanBodyResponse) Cutout(src image.Image) (*image.RGBA, error) {
+	return resp.Composite(src, image.Transparent)
+}
+
+/**
+ * Composite 将原图src中的人像合成到背景bg上
+ * 结果与蒙版大小相同，bg从左上角对齐绘制，不会缩放
+ * @param src 调用接口时使用的原图
+ * @param bg 新的背景，可以是 image.NewUniform(color) 等
+ */
+func (resp *SegmentHumanBodyResponse) Composite(src, bg image.Image) (*image.RGBA, error) {
+	mask, err := resp.Mask()
+	if err != nil {
+		return nil, err
+	}
+	bounds := mask.Bounds()
+	if src.Bounds().Dx() != bounds.Dx() || src.Bounds().Dy() != bounds.Dy() {
+		return nil, errors.New("原图与蒙版大小不一致")
+	}
+	dst := image.NewRGBA(bounds)
+	draw.Draw(dst, bounds, bg, bg.Bounds().Min, draw.Src)
+	draw.DrawMask(dst, bounds, src, src.Bounds().Min, mask, bounds.Min, draw.Over)
+	return dst, nil
+}
+
+// CompositeColor 将原图src中的人像合成到纯色背景上
+func (resp *SegmentHumanBodyResponse) CompositeColor(src image.Image, bg color.Color) (*image.RGBA, error) {
+	return resp.Composite(src, image.NewUniform(bg))
+}
+
+// HumanBodySegmentRequest 人体抠像对象
+type HumanBodySegmentRequest struct {
+	FaceRequest
+}
+
+// HumanBodySegment 构建一个人体抠像对象
+func (sdk *FaceSDK) HumanBodySegment(options ...map[string]interface{}) (*HumanBodySegmentRequest, error) {
+	humanBodySegmentRequest := new(HumanBodySegmentRequest)
+	humanBodySegmentRequest.FaceRequest = sdk.newFaceRequest(humanBodySegmentAPIURL, options)
+	return humanBodySegmentRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (hbsr *HumanBodySegmentRequest) SetImage(img, dt string) *HumanBodySegmentRequest {
+	hbsr.setImage(img, dt)
+	return hbsr
+}
+
+// SetReturnGrayscale 设置返回内容，可以是(SegmentReturnBodyImage|SegmentReturnAll|SegmentReturnGrayscale)
+func (hbsr *HumanBodySegmentRequest) SetReturnGrayscale(returnGrayscale int) *HumanBodySegmentRequest {
+	hbsr.options["return_grayscale"] = returnGrayscale
+	return hbsr
+}
+
+// SetOption 设置请求参数
+func (hbsr *HumanBodySegmentRequest) SetOption(key string, val interface{}) *HumanBodySegmentRequest {
+	hbsr.options[key] = val
+	return hbsr
+}
+
+// SetOptionMap 通过map设置请求参数
+func (hbsr *HumanBodySegmentRequest) SetOptionMap(options map[string]interface{}) *HumanBodySegmentRequest {
+	for key, val := range options {
+		hbsr.options[key] = val
+	}
+	return hbsr
+}
+
+// End 发送请求获取结果
+func (hbsr *HumanBodySegmentRequest) End() (*SegmentHumanBodyResponse, string, error) {
+	segmentHumanBodyResponse := new(SegmentHumanBodyResponse)
+	body, err := hbsr.end(segmentHumanBodyResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return segmentHumanBodyResponse, body, nil
+}
@@ -0,0 +1,128 @@
+package sdk
+
+import (
+	"math"
+)
+
+/**
+ * 人体骨骼关键点检测
+ * 检测图片中的人体，返回人体矩形框和头部、颈部、肩、肘、手、臀、膝、脚共 14 个关键点的位置及置信度。
+ * 关键点坐标相对于人体矩形框的左上角。
+ */
+
+const humanBodySkeletonAPIURL = humanBodyBaseURL + "/v1/skeleton"
+
+// SkeletonPointName 骨骼关键点名称
+type SkeletonPointName string
+
+const (
+	SkeletonHead          SkeletonPointName = "head"           // 头部
+	SkeletonNeck          SkeletonPointName = "neck"           // 颈部
+	SkeletonLeftShoulder  SkeletonPointName = "left_shoulder"  // 左肩
+	SkeletonLeftElbow     SkeletonPointName = "left_elbow"     // 左肘
+	SkeletonLeftHand      SkeletonPointName = "left_hand"      // 左手
+	SkeletonRightShoulder SkeletonPointName = "right_shoulder" // 右肩
+	SkeletonRightElbow    SkeletonPointName = "right_elbow"    // 右肘
+	SkeletonRightHand     SkeletonPointName = "right_hand"     // 右手
+	SkeletonLeftButtocks  SkeletonPointName = "left_buttocks"  // 左臀
+	SkeletonLeftKnee      SkeletonPointName = "left_knee"      // 左膝
+	SkeletonLeftFoot      SkeletonPointName = "left_foot"      // 左脚
+	SkeletonRightButtocks SkeletonPointName = "right_buttocks" // 右臀
+	SkeletonRightKnee     SkeletonPointName = "right_knee"     // 右膝
+	SkeletonRightFoot     SkeletonPointName = "right_foot"     // 右脚
+)
+
+// SkeletonPoint 骨骼关键点
+type SkeletonPoint struct {
+	X     int     `json:"x"`     // 横坐标，相对于人体矩形框左上角
+	Y     int     `json:"y"`     // 纵坐标，相对于人体矩形框左上角
+	Score float32 `json:"score"` // 置信度，范围[0,1]
+}
+
+// Skeleton 检测出的人体骨骼
+type Skeleton struct {
+	BodyRectangle Rectangle                            `json:"body_rectangle"` // 人体矩形框的位置
+	Landmark      map[SkeletonPointName]*SkeletonPoint `json:"landmark"`       // 骨骼关键点
+}
+
+// Point 获取关键点，置信度低于minScore或不存在时ok为false
+func (s *Skeleton) Point(name SkeletonPointName, minScore float32) (point *SkeletonPoint, ok bool) {
+	point, ok = s.Landmark[name]
+	if !ok || point == nil || point.Score < minScore {
+		return nil, false
+	}
+	return point, true
+}
+
+/**
+ * JointAngle 计算关节角度，即joint到a和joint到b两条线段的夹角，单位为度，范围[0,180]
+ * 例如 JointAngle(SkeletonLeftShoulder, SkeletonLeftElbow, SkeletonLeftHand, 0.5) 为左臂弯曲角度
+ * 任意关键点置信度低于minScore或两点重合时ok为false
+ */
+func (s *Skeleton) JointAngle(a, joint, b SkeletonPointName, minScore float32) (angle float64, ok bool) {
+	pa, okA := s.Point(a, minScore)
+	pj, okJ := s.Point(joint, minScore)
+	pb, okB := s.Point(b, minScore)
+	if !okA || !okJ || !okB {
+		return 0, false
+	}
+	ax, ay := float64(pa.X-pj.X), float64(pa.Y-pj.Y)
+	bx, by := float64(pb.X-pj.X), float64(pb.Y-pj.Y)
+	la, lb := math.Hypot(ax, ay), math.Hypot(bx, by)
+	if la == 0 || lb == 0 {
+		return 0, false
+	}
+	cos := (ax*bx + ay*by) / (la * lb)
+	cos = math.Max(-1, math.Min(1, cos))
+	return math.Acos(cos) * 180 / math.Pi, true
+}
+
+// SkeletonHumanBodyResponse 人体骨骼关键点检测响应数据
+type SkeletonHumanBodyResponse struct {
+	FaceResponse
+	ImageId   string      `json:"image_id"`  // 被检测的图片在系统中的标识
+	Skeletons []*Skeleton `json:"skeletons"` // 检测出的人体骨骼数组
+}
+
+// HumanBodySkeletonRequest 人体骨骼关键点检测对象
+type HumanBodySkeletonRequest struct {
+	FaceRequest
+}
+
+// HumanBodySkeleton 构建一个人体骨骼关键点检测对象
+func (sdk *FaceSDK) HumanBodySkeleton(options ...map[string]interface{}) (*HumanBodySkeletonRequest, error) {
+	humanBodySkeletonRequest := new(HumanBodySkeletonRequest)
+	humanBodySkeletonRequest.FaceRequest = sdk.newFaceRequest(humanBodySkeletonAPIURL, options)
+	return humanBodySkeletonRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (hbsr *HumanBodySkeletonRequest) SetImage(img, dt string) *HumanBodySkeletonRequest {
+	hbsr.setImage(img, dt)
+	return hbsr
+}
+
+// SetOption 设置请求参数
+func (hbsr *HumanBodySkeletonRequest) SetOption(key string, val interface{}) *HumanBodySkeletonRequest {
+	hbsr.options[key] = val
+	return hbsr
+}
+
+// SetOptionMap 通过map设置请求参数
+func (hbsr *HumanBodySkeletonRequest) SetOptionMap(options map[string]interface{}) *HumanBodySkeletonRequest {
+	for key, val := range options {
+		hbsr.options[key] = val
+	}
+	return hbsr
+}
+
+// End 发送请求获取结果
+func (hbsr *HumanBodySkeletonRequest) End() (*SkeletonHumanBodyResponse, string, error) {
+	skeletonHumanBodyResponse := new(SkeletonHumanBodyResponse)
+	body, err := hbsr.end(skeletonHumanBodyResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return skeletonHumanBodyResponse, body, nil
+}
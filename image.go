@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Base64Image 接口返回的 base64 编码图片
+type Base64Image string
+
+// Bytes 解码为图片文件的原始数据
+func (bi Base64Image) Bytes() ([]byte, error) {
+	data := string(bi)
+	// 兼容 data:image/jpeg;base64, 格式
+	if i := strings.Index(data, ";base64,"); i >= 0 && strings.HasPrefix(data, "data:") {
+		data = data[i+len(";base64,"):]
+	}
+	return base64.StdEncoding.DecodeString(data)
+}
+
+// Decode 解码为 image.Image，format为图片格式，如jpeg、png
+func (bi Base64Image) Decode() (img image.Image, format string, err error) {
+	data, err := bi.Bytes()
+	if err != nil {
+		return nil, "", err
+	}
+	return image.Decode(bytes.NewReader(data))
+}
+
+// WriteJPEG 以JPEG格式写入w，quality范围[1,100]，为0时使用默认质量
+func (bi Base64Image) WriteJPEG(w io.Writer, quality int) error {
+	img, _, err := bi.Decode()
+	if err != nil {
+		return err
+	}
+	if quality <= 0 {
+		quality = jpeg.DefaultQuality
+	}
+	return jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+}
+
+// WritePNG 以PNG格式写入w
+func (bi Base64Image) WritePNG(w io.Writer) error {
+	img, _, err := bi.Decode()
+	if err != nil {
+		return err
+	}
+	return png.Encode(w, img)
+}
+
+// SaveFile 保存为文件，扩展名为.png时保存为PNG，为.jpg或.jpeg时保存为JPEG，其他扩展名保存接口返回的原始数据
+func (bi Base64Image) SaveFile(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".png":
+		err = bi.WritePNG(f)
+	case ".jpg", ".jpeg":
+		err = bi.WriteJPEG(f, 0)
+	default:
+		var data []byte
+		if data, err = bi.Bytes(); err == nil {
+			_, err = f.Write(data)
+		}
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,78 @@
+package sdk
+
+/**
+ * 车牌识别
+ * 识别图片中的车牌，返回每个车牌的号码、颜色以及车牌所在的四边形区域。
+ */
+
+const licensePlateAPIURL = imageppBaseURL + "/v1/licenseplate"
+
+// LicensePlateColor 车牌颜色
+type LicensePlateColor string
+
+const (
+	LicensePlateBlue           LicensePlateColor = "blue"             // 蓝牌
+	LicensePlateYellow         LicensePlateColor = "yellow"           // 黄牌
+	LicensePlateBlack          LicensePlateColor = "black"            // 黑牌
+	LicensePlateWhite          LicensePlateColor = "white"            // 白牌
+	LicensePlateGreen          LicensePlateColor = "green"            // 绿牌
+	LicensePlateSmallNewEnergy LicensePlateColor = "small_new_energy" // 小型新能源车牌
+	LicensePlateLargeNewEnergy LicensePlateColor = "large_new_energy" // 大型新能源车牌
+	LicensePlateUnknown        LicensePlateColor = "unknown"          // 未知颜色
+)
+
+// LicensePlate 识别出的车牌
+type LicensePlate struct {
+	LicensePlateNumber string            `json:"license_plate_number"` // 车牌号码
+	Color              LicensePlateColor `json:"color"`                // 车牌颜色
+	Bound              Quadrilateral     `json:"bound"`                // 车牌在图片中的四边形区域
+}
+
+// LicensePlateResponse 车牌识别响应数据
+type LicensePlateResponse struct {
+	FaceResponse
+	Results []*LicensePlate `json:"results"` // 识别出的车牌数组，没有识别出时为空数组
+}
+
+// LicensePlateRequest 车牌识别对象
+type LicensePlateRequest struct {
+	FaceRequest
+}
+
+// LicensePlate 构建一个车牌识别对象
+func (sdk *FaceSDK) LicensePlate(options ...map[string]interface{}) (*LicensePlateRequest, error) {
+	licensePlateRequest := new(LicensePlateRequest)
+	licensePlateRequest.FaceRequest = sdk.newFaceRequest(licensePlateAPIURL, options)
+	return licensePlateRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (lpr *LicensePlateRequest) SetImage(img, dt string) *LicensePlateRequest {
+	lpr.setImage(img, dt)
+	return lpr
+}
+
+// SetOption 设置请求参数
+func (lpr *LicensePlateRequest) SetOption(key string, val interface{}) *LicensePlateRequest {
+	lpr.options[key] = val
+	return lpr
+}
+
+// SetOptionMap 通过map设置请求参数
+func (lpr *LicensePlateRequest) SetOptionMap(options map[string]interface{}) *LicensePlateRequest {
+	for key, val := range options {
+		lpr.options[key] = val
+	}
+	return lpr
+}
+
+// End 发送请求获取结果
+func (lpr *LicensePlateRequest) End() (*LicensePlateResponse, string, error) {
+	licensePlateResponse := new(LicensePlateResponse)
+	body, err := lpr.end(licensePlateResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return licensePlateResponse, body, nil
+}
@@ -0,0 +1,112 @@
+package sdk
+
+/**
+ * 人脸融合
+ * 将融合图中的人脸融合到模板图中指定的人脸上，返回融合后图片的 base64 编码。
+ * 模板图人脸框可以手动指定，也可以使用之前对模板图的人脸检测结果。
+ */
+
+const (
+	imageppBaseURL  = APIHost + "/imagepp"
+	mergeFaceAPIURL = imageppBaseURL + "/v1/mergeface"
+)
+
+// MergeFaceResponse 人脸融合响应数据
+type MergeFaceResponse struct {
+	FaceResponse
+	Result Base64Image `json:"result"` // 融合后的图片，jpg格式的base64编码
+}
+
+// MergeFaceRequest 人脸融合对象
+type MergeFaceRequest struct {
+	FaceRequest
+}
+
+// MergeFace 构建一个人脸融合对象
+func (sdk *FaceSDK) MergeFace(options ...map[string]interface{}) (*MergeFaceRequest, error) {
+	mergeFaceRequest := new(MergeFaceRequest)
+	mergeFaceRequest.FaceRequest = sdk.newFaceRequest(mergeFaceAPIURL, options)
+	return mergeFaceRequest, nil
+}
+
+// SetTemplate 设置模板图
+// dt可以是(template_url|template_file|template_base64)
+func (mfr *MergeFaceRequest) SetTemplate(img, dt string) *MergeFaceRequest {
+	mfr.setImage(img, dt)
+	return mfr
+}
+
+// SetTemplateRectangle 设置模板图中要被融合的人脸框
+func (mfr *MergeFaceRequest) SetTemplateRectangle(rect FaceRectangle) *MergeFaceRequest {
+	mfr.options["template_rectangle"] = rect.String()
+	return mfr
+}
+
+// SetTemplateFaceFrom 使用之前对模板图检测结果中第index个人脸的人脸框
+func (mfr *MergeFaceRequest) SetTemplateFaceFrom(dr *DetectFaceResponse, index int) *MergeFaceRequest {
+	face, err := dr.Face(index)
+	if err != nil {
+		mfr.err = err
+		return mfr
+	}
+	return mfr.SetTemplateRectangle(face.FaceRectangle)
+}
+
+// SetMerge 设置融合图
+// dt可以是(merge_url|merge_file|merge_base64)
+func (mfr *MergeFaceRequest) SetMerge(img, dt string) *MergeFaceRequest {
+	mfr.setImage(img, dt)
+	return mfr
+}
+
+// SetMergeRectangle 设置融合图中要使用的人脸框，不设置时使用最大的人脸
+func (mfr *MergeFaceRequest) SetMergeRectangle(rect FaceRectangle) *MergeFaceRequest {
+	mfr.options["merge_rectangle"] = rect.String()
+	return mfr
+}
+
+// SetMergeFaceFrom 使用之前对融合图检测结果中第index个人脸的人脸框
+func (mfr *MergeFaceRequest) SetMergeFaceFrom(dr *DetectFaceResponse, index int) *MergeFaceRequest {
+	face, err := dr.Face(index)
+	if err != nil {
+		mfr.err = err
+		return mfr
+	}
+	return mfr.SetMergeRectangle(face.FaceRectangle)
+}
+
+// SetMergeRate 设置融合比例，范围[0,100]，数值越大融合图中人脸的特征越明显，默认50
+func (mfr *MergeFaceRequest) SetMergeRate(mergeRate int) *MergeFaceRequest {
+	mfr.options["merge_rate"] = mergeRate
+	return mfr
+}
+
+// SetFeatureRate 设置五官融合比例，范围[0,100]，主要调节融合后图像五官的形状，默认45
+func (mfr *MergeFaceRequest) SetFeatureRate(featureRate int) *MergeFaceRequest {
+	mfr.options["feature_rate"] = featureRate
+	return mfr
+}
+
+// SetOption 设置请求参数
+func (mfr *MergeFaceRequest) SetOption(key string, val interface{}) *MergeFaceRequest {
+	mfr.options[key] = val
+	return mfr
+}
+
+// SetOptionMap 通过map设置请求参数
+func (mfr *MergeFaceRequest) SetOptionMap(options map[string]interface{}) *MergeFaceRequest {
+	for key, val := range options {
+		mfr.options[key] = val
+	}
+	return mfr
+}
+
+// End 发送请求获取结果
+func (mfr *MergeFaceRequest) End() (*MergeFaceResponse, string, error) {
+	mergeFaceResponse := new(MergeFaceResponse)
+	body, err := mfr.end(mergeFaceResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return mergeFaceResponse, body, nil
+}
@@ -0,0 +1,80 @@
+package sdk
+
+import (
+	"strings"
+)
+
+/**
+ * 银行卡识别
+ * 识别图片中的银行卡，返回卡号、所属银行、卡片类型以及银行卡所在的四边形区域。
+ */
+
+const ocrBankCardAPIURL = cardppBaseURL + "/v1/ocrbankcard"
+
+// BankCard 识别出的银行卡
+type BankCard struct {
+	Bound      Quadrilateral `json:"bound"`      // 银行卡在图片中的四边形区域
+	Number     string        `json:"number"`     // 卡号
+	Bank       string        `json:"bank"`       // 所属银行
+	CardType   string        `json:"card_type"`  // 卡片类型，如借记卡、信用卡
+	Confidence float32       `json:"confidence"` // 识别置信度
+}
+
+// NumberDigits 返回去除空格等分隔符后的卡号
+func (card *BankCard) NumberDigits() string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, card.Number)
+}
+
+// OCRBankCardResponse 银行卡识别响应数据
+type OCRBankCardResponse struct {
+	FaceResponse
+	BankCards []*BankCard `json:"bank_cards"` // 识别出的银行卡数组，没有识别出时为空数组
+}
+
+// OCRBankCardRequest 银行卡识别对象
+type OCRBankCardRequest struct {
+	FaceRequest
+}
+
+// OCRBankCard 构建一个银行卡识别对象
+func (sdk *FaceSDK) OCRBankCard(options ...map[string]interface{}) (*OCRBankCardRequest, error) {
+	ocrBankCardRequest := new(OCRBankCardRequest)
+	ocrBankCardRequest.FaceRequest = sdk.newFaceRequest(ocrBankCardAPIURL, options)
+	return ocrBankCardRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (obr *OCRBankCardRequest) SetImage(img, dt string) *OCRBankCardRequest {
+	obr.setImage(img, dt)
+	return obr
+}
+
+// SetOption 设置请求参数
+func (obr *OCRBankCardRequest) SetOption(key string, val interface{}) *OCRBankCardRequest {
+	obr.options[key] = val
+	return obr
+}
+
+// SetOptionMap 通过map设置请求参数
+func (obr *OCRBankCardRequest) SetOptionMap(options map[string]interface{}) *OCRBankCardRequest {
+	for key, val := range options {
+		obr.options[key] = val
+	}
+	return obr
+}
+
+// End 发送请求获取结果
+func (obr *OCRBankCardRequest) End() (*OCRBankCardResponse, string, error) {
+	ocrBankCardResponse := new(OCRBankCardResponse)
+	body, err := obr.end(ocrBankCardResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return ocrBankCardResponse, body, nil
+}
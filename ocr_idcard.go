@@ -0,0 +1,114 @@
+package sdk
+
+/**
+ * 身份证识别
+ * 识别图片中的二代身份证，正面返回姓名、性别、民族、出生日期、住址、身份证号，背面返回签发机关和有效期限，
+ * 并可以返回证件真实性判断（原件、临时身份证、复印件、翻拍、PS）的置信度。
+ */
+
+const (
+	cardppBaseURL   = APIHost + "/cardpp"
+	ocrIDCardAPIURL = cardppBaseURL + "/v1/ocridcard"
+)
+
+const (
+	CardSideFront = "front" // 正面
+	CardSideBack  = "back"  // 背面
+)
+
+// IDCardLegality 身份证真实性判断，每项为置信度，范围[0,1]
+type IDCardLegality struct {
+	IDPhoto          float32 `json:"ID Photo"`           // 正式身份证照片
+	TemporaryIDPhoto float32 `json:"Temporary ID Photo"` // 临时身份证照片
+	Photocopy        float32 `json:"Photocopy"`          // 正式身份证的复印件
+	Screen           float32 `json:"Screen"`             // 手机或电脑屏幕翻拍的照片
+	Edited           float32 `json:"Edited"`             // 用工具合成或者编辑过的身份证图片
+}
+
+// IsGenuine 判断原件（正式或临时身份证照片）的置信度是否不低于minConfidence且高于其他各项
+func (l *IDCardLegality) IsGenuine(minConfidence float32) bool {
+	genuine := l.IDPhoto
+	if l.TemporaryIDPhoto > genuine {
+		genuine = l.TemporaryIDPhoto
+	}
+	return genuine >= minConfidence && genuine > l.Photocopy && genuine > l.Screen && genuine > l.Edited
+}
+
+// IDCard 识别出的身份证
+type IDCard struct {
+	Type         int             `json:"type"`           // 证件类型，1 表示身份证
+	Side         string          `json:"side"`           // 证件的正反面 front|back
+	Name         string          `json:"name"`           // 姓名，仅正面返回
+	Gender       string          `json:"gender"`         // 性别 男|女，仅正面返回
+	Nationality  string          `json:"race"`           // 民族，仅正面返回
+	Birthday     string          `json:"birthday"`       // 出生日期，格式为 YYYY-MM-DD，仅正面返回
+	Address      string          `json:"address"`        // 住址，仅正面返回
+	IDCardNumber string          `json:"id_card_number"` // 身份证号，仅正面返回
+	IssuedBy     string          `json:"issued_by"`      // 签发机关，仅背面返回
+	ValidDate    string          `json:"valid_date"`     // 有效期限，格式为 YYYY.MM.DD-YYYY.MM.DD，仅背面返回
+	Legality     *IDCardLegality `json:"legality"`       // 证件真实性判断，需要设置 legality 为1
+}
+
+// IsFront 是否为身份证正面
+func (card *IDCard) IsFront() bool {
+	return card.Side == CardSideFront
+}
+
+// OCRIDCardResponse 身份证识别响应数据
+type OCRIDCardResponse struct {
+	FaceResponse
+	Cards []*IDCard `json:"cards"` // 识别出的身份证数组，没有识别出时为空数组
+}
+
+// OCRIDCardRequest 身份证识别对象
+type OCRIDCardRequest struct {
+	FaceRequest
+}
+
+// OCRIDCard 构建一个身份证识别对象
+func (sdk *FaceSDK) OCRIDCard(options ...map[string]interface{}) (*OCRIDCardRequest, error) {
+	ocrIDCardRequest := new(OCRIDCardRequest)
+	ocrIDCardRequest.FaceRequest = sdk.newFaceRequest(ocrIDCardAPIURL, options)
+	return ocrIDCardRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (oir *OCRIDCardRequest) SetImage(img, dt string) *OCRIDCardRequest {
+	oir.setImage(img, dt)
+	return oir
+}
+
+// SetLegality 设置是否返回证件真实性判断
+func (oir *OCRIDCardRequest) SetLegality(legality bool) *OCRIDCardRequest {
+	if legality {
+		oir.options["legality"] = 1
+	} else {
+		oir.options["legality"] = 0
+	}
+	return oir
+}
+
+// SetOption 设置请求参数
+func (oir *OCRIDCardRequest) SetOption(key string, val interface{}) *OCRIDCardRequest {
+	oir.options[key] = val
+	return oir
+}
+
+// SetOptionMap 通过map设置请求参数
+func (oir *OCRIDCardRequest) SetOptionMap(options map[string]interface{}) *OCRIDCardRequest {
+	for key, val := range options {
+		oir.options[key] = val
+	}
+	return oir
+}
+
+// End 发送请求获取结果
+func (oir *OCRIDCardRequest) End() (*OCRIDCardResponse, string, error) {
+	ocrIDCardResponse := new(OCRIDCardResponse)
+	body, err := oir.end(ocrIDCardResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return ocrIDCardResponse, body, nil
+}
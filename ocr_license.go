@@ -0,0 +1,181 @@
+package sdk
+
+/**
+ * 驾驶证、行驶证识别
+ * 驾驶证识别使用 v2 接口，支持主页和副页，可以通过 mode 选择快速模式或完备模式；
+ * 行驶证识别返回号牌号码、车辆类型、所有人、车辆识别代号、发动机号码、注册日期等信息。
+ * 行驶证接口只识别主页（正面），不返回副页的档案编号、核定载人数、总质量、检验记录等信息。
+ */
+
+const (
+	ocrDriverLicenseAPIURL  = cardppBaseURL + "/v2/ocrdriverlicense"
+	ocrVehicleLicenseAPIURL = cardppBaseURL + "/v1/ocrvehiclelicense"
+)
+
+const (
+	DriverLicenseModeFast     = "fast"     // 快速模式，只识别主页
+	DriverLicenseModeComplete = "complete" // 完备模式，识别主页和副页
+)
+
+// OCRField 识别出的字段
+type OCRField struct {
+	Content    string  `json:"content"`    // 字段内容
+	Confidence float32 `json:"confidence"` // 置信度，需要设置 return_score 为1
+}
+
+// DriverLicenseMain 驾驶证主页
+type DriverLicenseMain struct {
+	Version       OCRField `json:"version"`        // 驾驶证版本
+	LicenseNumber OCRField `json:"license_number"` // 证号
+	Name          OCRField `json:"name"`           // 姓名
+	Gender        OCRField `json:"gender"`         // 性别
+	Nationality   OCRField `json:"nationality"`    // 国籍
+	Address       OCRField `json:"address"`        // 住址
+	Birthday      OCRField `json:"birthday"`       // 出生日期
+	IssueDate     OCRField `json:"issue_date"`     // 初次领证日期
+	Class         OCRField `json:"class"`          // 准驾车型
+	ValidFrom     OCRField `json:"valid_from"`     // 有效起始日期
+	ValidFor      OCRField `json:"valid_for"`      // 有效年限
+	ValidTo       OCRField `json:"valid_to"`       // 有效截止日期
+	IssuedBy      OCRField `json:"issued_by"`      // 发证机关
+}
+
+// DriverLicenseSecond 驾驶证副页
+type DriverLicenseSecond struct {
+	LicenseNumber OCRField `json:"license_number"` // 证号
+	Name          OCRField `json:"name"`           // 姓名
+	FileNumber    OCRField `json:"file_number"`    // 档案编号
+	Record        OCRField `json:"record"`         // 记录
+}
+
+// OCRDriverLicenseResponse 驾驶证识别响应数据
+type OCRDriverLicenseResponse struct {
+	FaceResponse
+	Main   []*DriverLicenseMain   `json:"main"`   // 识别出的驾驶证主页
+	Second []*DriverLicenseSecond `json:"second"` // 识别出的驾驶证副页，完备模式下返回
+}
+
+// OCRDriverLicenseRequest 驾驶证识别对象
+type OCRDriverLicenseRequest struct {
+	FaceRequest
+}
+
+// OCRDriverLicense 构建一个驾驶证识别对象
+func (sdk *FaceSDK) OCRDriverLicense(options ...map[string]interface{}) (*OCRDriverLicenseRequest, error) {
+	ocrDriverLicenseRequest := new(OCRDriverLicenseRequest)
+	ocrDriverLicenseRequest.FaceRequest = sdk.newFaceRequest(ocrDriverLicenseAPIURL, options)
+	return ocrDriverLicenseRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (odlr *OCRDriverLicenseRequest) SetImage(img, dt string) *OCRDriverLicenseRequest {
+	odlr.setImage(img, dt)
+	return odlr
+}
+
+// SetMode 设置识别模式，可以是(DriverLicenseModeFast|DriverLicenseModeComplete)
+func (odlr *OCRDriverLicenseRequest) SetMode(mode string) *OCRDriverLicenseRequest {
+	odlr.options["mode"] = mode
+	return odlr
+}
+
+// SetReturnScore 设置是否返回每个字段的置信度
+func (odlr *OCRDriverLicenseRequest) SetReturnScore(returnScore bool) *OCRDriverLicenseRequest {
+	if returnScore {
+		odlr.options["return_score"] = 1
+	} else {
+		odlr.options["return_score"] = 0
+	}
+	return odlr
+}
+
+// SetOption 设置请求参数
+func (odlr *OCRDriverLicenseRequest) SetOption(key string, val interface{}) *OCRDriverLicenseRequest {
+	odlr.options[key] = val
+	return odlr
+}
+
+// SetOptionMap 通过map设置请求参数
+func (odlr *OCRDriverLicenseRequest) SetOptionMap(options map[string]interface{}) *OCRDriverLicenseRequest {
+	for key, val := range options {
+		odlr.options[key] = val
+	}
+	return odlr
+}
+
+// End 发送请求获取结果
+func (odlr *OCRDriverLicenseRequest) End() (*OCRDriverLicenseResponse, string, error) {
+	ocrDriverLicenseResponse := new(OCRDriverLicenseResponse)
+	body, err := odlr.end(ocrDriverLicenseResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return ocrDriverLicenseResponse, body, nil
+}
+
+// VehicleLicense 识别出的行驶证
+type VehicleLicense struct {
+	Type         int    `json:"type"`          // 证件类型
+	Side         string `json:"side"`          // 证件的正反面，只识别主页，固定为 front
+	PlateNo      string `json:"plate_no"`      // 号牌号码
+	VehicleType  string `json:"vehicle_type"`  // 车辆类型
+	Owner        string `json:"owner"`         // 所有人
+	Address      string `json:"address"`       // 住址
+	UseCharacter string `json:"use_character"` // 使用性质
+	Model        string `json:"model"`         // 品牌型号
+	Vin          string `json:"vin"`           // 车辆识别代号
+	EngineNo     string `json:"engine_no"`     // 发动机号码
+	RegisterDate string `json:"register_date"` // 注册日期
+	IssueDate    string `json:"issue_date"`    // 发证日期
+	IssuedBy     string `json:"issued_by"`     // 发证单位
+}
+
+// OCRVehicleLicenseResponse 行驶证识别响应数据
+type OCRVehicleLicenseResponse struct {
+	FaceResponse
+	Cards []*VehicleLicense `json:"cards"` // 识别出的行驶证数组，没有识别出时为空数组
+}
+
+// OCRVehicleLicenseRequest 行驶证识别对象
+type OCRVehicleLicenseRequest struct {
+	FaceRequest
+}
+
+// OCRVehicleLicense 构建一个行驶证识别对象
+func (sdk *FaceSDK) OCRVehicleLicense(options ...map[string]interface{}) (*OCRVehicleLicenseRequest, error) {
+	ocrVehicleLicenseRequest := new(OCRVehicleLicenseRequest)
+	ocrVehicleLicenseRequest.FaceRequest = sdk.newFaceRequest(ocrVehicleLicenseAPIURL, options)
+	return ocrVehicleLicenseRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (ovlr *OCRVehicleLicenseRequest) SetImage(img, dt string) *OCRVehicleLicenseRequest {
+	ovlr.setImage(img, dt)
+	return ovlr
+}
+
+// SetOption 设置请求参数
+func (ovlr *OCRVehicleLicenseRequest) SetOption(key string, val interface{}) *OCRVehicleLicenseRequest {
+	ovlr.options[key] = val
+	return ovlr
+}
+
+// SetOptionMap 通过map设置请求参数
+func (ovlr *OCRVehicleLicenseRequest) SetOptionMap(options map[string]interface{}) *OCRVehicleLicenseRequest {
+	for key, val := range options {
+		ovlr.options[key] = val
+	}
+	return ovlr
+}
+
+// End 发送请求获取结果
+func (ovlr *OCRVehicleLicenseRequest) End() (*OCRVehicleLicenseResponse, string, error) {
+	ocrVehicleLicenseResponse := new(OCRVehicleLicenseResponse)
+	body, err := ovlr.end(ocrVehicleLicenseResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return ocrVehicleLicenseResponse, body, nil
+}
@@ -0,0 +1,173 @@
+package sdk
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+)
+
+/**
+ * 通用文字识别
+ * 识别图片中的文字，按 文本行 -> 单词 -> 字符 的层级返回每一级的内容和位置。
+ * 可以通过 Text 按阅读顺序（从上到下、从左到右）转换为纯文本。
+ */
+
+const recognizeTextAPIURL = imageppBaseURL + "/v1/recognizetext"
+
+const (
+	TextObjectTypeLine      = "textline"  // 文本行
+	TextObjectTypeWord      = "word"      // 单词
+	TextObjectTypeCharacter = "character" // 字符
+)
+
+// TextObject 识别出的文字对象
+type TextObject struct {
+	Type         string        `json:"type"`          // 对象类型 textline|word|character
+	Value        string        `json:"value"`         // 识别出的文字
+	Position     []Point       `json:"position"`      // 对象所在多边形区域的顶点
+	ChildObjects []*TextObject `json:"child-objects"` // 下一级对象
+}
+
+// UnmarshalJSON 解析下一级对象，兼容 child_objects 写法
+func (to *TextObject) UnmarshalJSON(data []byte) error {
+	type textObject TextObject
+	obj := struct {
+		*textObject
+		ChildObjects []*TextObject `json:"child_objects"`
+	}{textObject: (*textObject)(to)}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	if len(to.ChildObjects) == 0 {
+		to.ChildObjects = obj.ChildObjects
+	}
+	return nil
+}
+
+// Bounds 返回包含对象的最小矩形框
+func (to *TextObject) Bounds() FaceRectangle {
+	return boundingRectangle(to.Position)
+}
+
+// Characters 返回对象下的全部字符，顺序与接口返回一致
+func (to *TextObject) Characters() []*TextObject {
+	if to.Type == TextObjectTypeCharacter || len(to.ChildObjects) == 0 {
+		return []*TextObject{to}
+	}
+	characters := make([]*TextObject, 0)
+	for _, child := range to.ChildObjects {
+		characters = append(characters, child.Characters()...)
+	}
+	return characters
+}
+
+// RecognizeTextResponse 通用文字识别响应数据
+type RecognizeTextResponse struct {
+	FaceResponse
+	Result []*TextObject `json:"result"` // 识别出的文本行数组
+}
+
+/**
+ * Lines 按阅读顺序返回全部文本行
+ * 按垂直方向中心点从上到下依次处理，中心点与当前行平均中心点的距离不超过当前行平均高度一半的文本行视为同一行，
+ * 同一行内从左到右排列
+ */
+func (resp *RecognizeTextResponse) Lines() [][]*TextObject {
+	lines := make([]*TextObject, 0, len(resp.Result))
+	for _, obj := range resp.Result {
+		if obj.Type == TextObjectTypeLine || obj.Type == "" {
+			lines = append(lines, obj)
+		}
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		bi, bj := lines[i].Bounds(), lines[j].Bounds()
+		return bi.Top*2+bi.Height < bj.Top*2+bj.Height
+	})
+
+	rows := make([][]*TextObject, 0)
+	var row []*TextObject
+	var sumCenter, sumHeight float64
+	for _, line := range lines {
+		bounds := line.Bounds()
+		centerY := float64(bounds.Top) + float64(bounds.Height)/2
+		if row != nil {
+			n := float64(len(row))
+			if math.Abs(centerY-sumCenter/n) <= sumHeight/n/2 {
+				row = append(row, line)
+				sumCenter += centerY
+				sumHeight += float64(bounds.Height)
+				continue
+			}
+			rows = append(rows, row)
+		}
+		row = []*TextObject{line}
+		sumCenter, sumHeight = centerY, float64(bounds.Height)
+	}
+	if row != nil {
+		rows = append(rows, row)
+	}
+	for _, r := range rows {
+		sort.SliceStable(r, func(i, j int) bool {
+			return r[i].Bounds().Left < r[j].Bounds().Left
+		})
+	}
+	return rows
+}
+
+// Text 按阅读顺序转换为纯文本，同一行的文本用空格分隔，不同行用换行分隔
+func (resp *RecognizeTextResponse) Text() string {
+	rows := resp.Lines()
+	texts := make([]string, len(rows))
+	for i, row := range rows {
+		values := make([]string, len(row))
+		for j, line := range row {
+			values[j] = line.Value
+		}
+		texts[i] = strings.Join(values, " ")
+	}
+	return strings.Join(texts, "\n")
+}
+
+// RecognizeTextRequest 通用文字识别对象
+type RecognizeTextRequest struct {
+	FaceRequest
+}
+
+// RecognizeText 构建一个通用文字识别对象
+func (sdk *FaceSDK) RecognizeText(options ...map[string]interface{}) (*RecognizeTextRequest, error) {
+	recognizeTextRequest := new(RecognizeTextRequest)
+	recognizeTextRequest.FaceRequest = sdk.newFaceRequest(recognizeTextAPIURL, options)
+	return recognizeTextRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (rtr *RecognizeTextRequest) SetImage(img, dt string) *RecognizeTextRequest {
+	rtr.setImage(img, dt)
+	return rtr
+}
+
+// SetOption 设置请求参数
+func (rtr *RecognizeTextRequest) SetOption(key string, val interface{}) *RecognizeTextRequest {
+	rtr.options[key] = val
+	return rtr
+}
+
+// SetOptionMap 通过map设置请求参数
+func (rtr *RecognizeTextRequest) SetOptionMap(options map[string]interface{}) *RecognizeTextRequest {
+	for key, val := range options {
+		rtr.options[key] = val
+	}
+	return rtr
+}
+
+// End 发送请求获取结果
+func (rtr *RecognizeTextRequest) End() (*RecognizeTextResponse, string, error) {
+	recognizeTextResponse := new(RecognizeTextResponse)
+	body, err := rtr.end(recognizeTextResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return recognizeTextResponse, body, nil
+}
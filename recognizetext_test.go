@@ -0,0 +1,108 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// 生成一个文本行，skew 为右侧相对左侧在垂直方向的偏移，用于模拟倾斜的文本行
+func textLine(value string, left, top, width, height, skew int) *TextObject {
+	return &TextObject{
+		Type:  TextObjectTypeLine,
+		Value: value,
+		Position: []Point{
+			{X: left, Y: top},
+			{X: left + width, Y: top + skew},
+			{X: left + width, Y: top + height + skew},
+			{X: left, Y: top + height},
+		},
+	}
+}
+
+func TestRecognizeTextResponseText(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []*TextObject
+		want  string
+	}{
+		{
+			name: "empty",
+			want: "",
+		},
+		{
+			name: "rows out of order",
+			lines: []*TextObject{
+				textLine("third", 0, 100, 80, 20, 0),
+				textLine("first", 0, 0, 80, 20, 0),
+				textLine("second", 0, 50, 80, 20, 0),
+			},
+			want: "first\nsecond\nthird",
+		},
+		{
+			name: "same row right to left",
+			lines: []*TextObject{
+				textLine("world", 100, 2, 60, 20, 0),
+				textLine("hello", 0, 0, 60, 20, 0),
+			},
+			want: "hello world",
+		},
+		{
+			name: "same row slightly shifted",
+			lines: []*TextObject{
+				textLine("name:", 0, 10, 50, 20, 0),
+				textLine("zhang", 60, 16, 50, 20, 0),
+				textLine("san", 120, 5, 40, 20, 0),
+			},
+			want: "name: zhang san",
+		},
+		{
+			name: "skewed rows",
+			lines: []*TextObject{
+				textLine("line two", 10, 45, 200, 20, 8),
+				textLine("line one", 0, 0, 200, 20, 8),
+				textLine("line three", 20, 90, 200, 20, 8),
+			},
+			want: "line one\nline two\nline three",
+		},
+		{
+			name: "overlapping rows",
+			lines: []*TextObject{
+				textLine("lower", 0, 15, 80, 20, 0),
+				textLine("upper", 0, 0, 80, 20, 0),
+			},
+			want: "upper\nlower",
+		},
+		{
+			name: "words and characters at top level are ignored",
+			lines: []*TextObject{
+				textLine("line", 0, 0, 80, 20, 0),
+				{Type: TextObjectTypeWord, Value: "word", Position: []Point{{X: 0, Y: 0}}},
+				{Type: TextObjectTypeCharacter, Value: "c", Position: []Point{{X: 0, Y: 0}}},
+			},
+			want: "line",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &RecognizeTextResponse{Result: tt.lines}
+			if got := resp.Text(); got != tt.want {
+				t.Errorf("Text() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextObjectChildObjects(t *testing.T) {
+	for _, key := range []string{"child-objects", "child_objects"} {
+		body := `{"result":[{"type":"textline","value":"ab","position":[{"x":0,"y":0},{"x":20,"y":0},{"x":20,"y":10},{"x":0,"y":10}],
+			"` + key + `":[{"type":"word","value":"ab","` + key + `":[{"type":"character","value":"a"},{"type":"character","value":"b"}]}]}]}`
+		resp := new(RecognizeTextResponse)
+		if err := json.Unmarshal([]byte(body), resp); err != nil {
+			t.Fatalf("%s: %v", key, err)
+		}
+		characters := resp.Result[0].Characters()
+		if len(characters) != 2 || characters[0].Value != "a" || characters[1].Value != "b" {
+			t.Fatalf("%s: Characters() = %+v", key, characters)
+		}
+	}
+}
@@ -1,30 +1,49 @@
 package sdk
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/parnurzeal/gorequest"
 )
 
 const (
-	APIBaseURL = "https://api-cn.faceplusplus.com/facepp/v3"
+	APIHost    = "https://api-cn.faceplusplus.com"
+	APIBaseURL = APIHost + "/facepp/v3"
 )
 
 // FaceSDK Face++ sdk 对象
 type FaceSDK struct {
-	APIKey    string
-	APISecret string
-	Debug     bool // 是否调试
+	APIKey     string
+	APISecret  string
+	Debug      bool // 是否调试
+	DefaultFAR FAR  // 比对、搜索结果判断是否为同一个人时默认使用的误识率
+
+	MaxRetries    int           // 并发超限（403 CONCURRENCY_LIMIT_EXCEEDED）或服务端错误（5xx）时的最大重试次数，默认为0不重试；开启后非幂等的请求（如addface、create）可能被重复执行
+	RetryInterval time.Duration // 第一次重试前的等待时间，之后每次翻倍，为0时使用 DefaultRetryInterval
 }
 
+// DefaultRetryInterval 默认的第一次重试等待时间
+const DefaultRetryInterval = 500 * time.Millisecond
+
 // FaceRequest 请求操作对象
 type FaceRequest struct {
 	options map[string]interface{}
 	request *gorequest.SuperAgent
+	err     error // 设置参数时产生的错误，在End时返回
+	retry   retryPolicy
+	ctx     context.Context // 重试等待时监听的ctx，为nil时不可取消
+}
+
+// retryPolicy 请求失败时的重试策略
+type retryPolicy struct {
+	maxRetries int
+	interval   time.Duration
 }
 
 /**
@@ -38,8 +57,9 @@ func NewFaceSDK(apiKey, apiSecret string, debug ...bool) (*FaceSDK, error) {
 		return nil, errors.New("API Key 和 API Secret 不能为空")
 	}
 	faceSDK := &FaceSDK{
-		APIKey:    apiKey,
-		APISecret: apiSecret,
+		APIKey:     apiKey,
+		APISecret:  apiSecret,
+		DefaultFAR: DefaultFAR,
 	}
 	if len(debug) > 0 {
 		faceSDK.Debug = debug[0]
@@ -54,6 +74,117 @@ func (sdk *FaceSDK) getHTTPRequest() *gorequest.SuperAgent {
 	return superAgent
 }
 
+// 当前的重试策略
+func (sdk *FaceSDK) retryPolicy() retryPolicy {
+	interval := sdk.RetryInterval
+	if interval <= 0 {
+		interval = DefaultRetryInterval
+	}
+	return retryPolicy{
+		maxRetries: sdk.MaxRetries,
+		interval:   interval,
+	}
+}
+
+// 创建请求对象，添加api key信息并设置请求地址
+func (sdk *FaceSDK) newFaceRequest(urlStr string, options []map[string]interface{}) FaceRequest {
+	faceRequest := FaceRequest{}
+	if len(options) == 0 {
+		faceRequest.options = make(map[string]interface{}, 0)
+	} else {
+		faceRequest.options = options[0]
+	}
+	faceRequest.options["api_key"] = sdk.APIKey
+	faceRequest.options["api_secret"] = sdk.APISecret
+
+	faceRequest.request = sdk.getHTTPRequest().
+		Post(urlStr).
+		Type("multipart")
+	faceRequest.retry = sdk.retryPolicy()
+	return faceRequest
+}
+
+// 设置图片参数，dt为文件类型(如image_file、template_file)时上传文件，否则作为普通参数
+func (fr *FaceRequest) setImage(img, dt string) {
+	if strings.Contains(dt, "_file") {
+		fr.request.SendFile(img, "", dt)
+	} else {
+		fr.options[dt] = img
+	}
+}
+
+// 发送请求并将结果解析到response，返回响应body
+func (fr *FaceRequest) end(response interface{}) (string, error) {
+	if fr.err != nil {
+		return "", fr.err
+	}
+	body, err := fr.send()
+	if err != nil {
+		return "", err
+	}
+	// 解析body为对象
+	err = json.Unmarshal([]byte(body), response)
+	if err != nil {
+		return "", err
+	}
+	return body, nil
+}
+
+/**
+ * 发送请求并返回响应body，并发超限或服务端错误时按重试策略等待后重试
+ * 网络错误会保留在请求对象中，无法使用同一个请求对象重试，直接返回；等待期间ctx结束时返回ctx的错误
+ */
+func (fr *FaceRequest) send() (string, error) {
+	interval := fr.retry.interval
+	for retries := 0; ; retries++ {
+		resp, body, errs := fr.request.SendMap(fr.options).End()
+		if len(errs) > 0 {
+			return "", errors.New("请求接口错误:" + errs[0].Error())
+		}
+		// 判断响应是否成功
+		if resp.StatusCode == http.StatusOK {
+			return body, nil
+		}
+		err := NewFaceError(resp.StatusCode, body)
+		if retries >= fr.retry.maxRetries || !isRetryableError(err) {
+			return "", err
+		}
+		if err := fr.wait(interval); err != nil {
+			return "", err
+		}
+		interval *= 2
+	}
+}
+
+// 重试前等待interval，ctx结束时返回ctx的错误
+func (fr *FaceRequest) wait(interval time.Duration) error {
+	ctx := fr.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// 设置重试等待时监听的ctx
+func (fr *FaceRequest) withContext(ctx context.Context) {
+	fr.ctx = ctx
+}
+
+// 是否为可以重试的错误：并发超限或服务端错误
+func isRetryableError(err *FaceError) bool {
+	if err.Code >= http.StatusInternalServerError {
+		return true
+	}
+	return err.Code == http.StatusForbidden && IsFaceError(err, ErrorConcurrencyLimitExceeded)
+}
+
 // FaceResponse 接口返回数据结构体
 type FaceResponse struct {
 	RequestId    string `json:"request_id"`    // 用于区分每一次请求的唯一的字符串。此字符串可以用于后续数据反查。
@@ -75,15 +206,66 @@ func (fe *FaceError) Error() string {
 
 // Face 数组中单个元素的结构
 type Face struct {
-	FaceToken     string `json:"face_token"` // 人脸的标识
-	FaceRectangle struct {
-		Top    int `json:"top"`
-		Left   int `json:"left"`
-		Width  int `json:"width"`
-		Height int `json:"height"`
-	} `json:"face_rectangle"` // 人脸矩形框的位置，包括以下属性
-	Landmark   map[string]*Landmark `json:"landmark"`   // 人脸的关键点坐标数组
-	Attributes *Attributes          `json:"attributes"` // 人脸属性特征，具体包含的信息见下表
+	FaceToken     string               `json:"face_token"`     // 人脸的标识
+	FaceRectangle FaceRectangle        `json:"face_rectangle"` // 人脸矩形框的位置
+	Landmark      map[string]*Landmark `json:"landmark"`       // 人脸的关键点坐标数组
+	Attributes    *Attributes          `json:"attributes"`     // 人脸属性特征，具体包含的信息见下表
+}
+
+// FaceRectangle 人脸矩形框的位置
+type FaceRectangle struct {
+	Top    int `json:"top"`    // 矩形框左上角像素点的纵坐标
+	Left   int `json:"left"`   // 矩形框左上角像素点的横坐标
+	Width  int `json:"width"`  // 矩形框的宽度
+	Height int `json:"height"` // 矩形框的高度
+}
+
+// String 转换为接口参数使用的格式 top,left,width,height
+func (fr FaceRectangle) String() string {
+	return fmt.Sprintf("%d,%d,%d,%d", fr.Top, fr.Left, fr.Width, fr.Height)
+}
+
+// Point 像素点坐标
+type Point struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// Quadrilateral 四边形区域，用于证件、车牌等可能倾斜的目标
+type Quadrilateral struct {
+	LeftTop     Point `json:"left_top"`     // 左上角
+	RightTop    Point `json:"right_top"`    // 右上角
+	RightBottom Point `json:"right_bottom"` // 右下角
+	LeftBottom  Point `json:"left_bottom"`  // 左下角
+}
+
+// Bounds 返回包含四边形的最小矩形框
+func (q Quadrilateral) Bounds() FaceRectangle {
+	return boundingRectangle([]Point{q.LeftTop, q.RightTop, q.RightBottom, q.LeftBottom})
+}
+
+// 返回包含全部点的最小矩形框
+func boundingRectangle(points []Point) FaceRectangle {
+	if len(points) == 0 {
+		return FaceRectangle{}
+	}
+	minX, minY := points[0].X, points[0].Y
+	maxX, maxY := minX, minY
+	for _, p := range points[1:] {
+		if p.X < minX {
+			minX = p.X
+		}
+		if p.X > maxX {
+			maxX = p.X
+		}
+		if p.Y < minY {
+			minY = p.Y
+		}
+		if p.Y > maxY {
+			maxY = p.Y
+		}
+	}
+	return FaceRectangle{Top: minY, Left: minX, Width: maxX - minX, Height: maxY - minY}
 }
 
 // Landmark 关键点坐标
@@ -133,6 +315,24 @@ type Attributes struct {
 	Skinstatus map[string]float32 `json:"skinstatus"` // 面部特征识别结果 health:健康 stain:色斑 acne:青春痘 dark_circle:黑眼圈
 }
 
+// 接口返回的部分错误信息 error_message
+const (
+	ErrorFaceSetExist    = "FACESET_EXIST"     // 创建FaceSet时outer_id已经存在
+	ErrorFaceSetNotExist = "FACESET_NOT_EXIST" // faceset_token或outer_id对应的FaceSet不存在
+	ErrorEmptyFaceSet    = "EMPTY_FACESET"     // 搜索的FaceSet中没有face_token
+
+	ErrorConcurrencyLimitExceeded = "CONCURRENCY_LIMIT_EXCEEDED" // 并发数超过限制
+)
+
+// IsFaceError 判断err是否为接口返回的指定错误，errorMessage可以是完整错误信息或冒号前的错误类型
+func IsFaceError(err error, errorMessage string) bool {
+	var fe *FaceError
+	if !errors.As(err, &fe) {
+		return false
+	}
+	return fe.ErrorMessage == errorMessage || strings.HasPrefix(fe.ErrorMessage, errorMessage+":")
+}
+
 /**
  * NewFaceError 创建一个错误
  * @param code http 错误码
@@ -1,26 +1,38 @@
 package sdk
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
-	"net/http"
+	"sort"
 )
 
 /**
  * 文档地址：https://console.faceplusplus.com.cn/documents/4888381
  * 在一个已有的 FaceSet 中找出与目标人脸最相似的一张或多张人脸，返回置信度和不同误识率下的阈值。
  * 支持传入图片或 face_token 进行人脸搜索。使用图片进行搜索时会选取图片中检测到人脸尺寸最大的一个人脸。
+ * 可以通过 face_rectangle 指定图片中的人脸，或者使用之前检测结果中的 face_token。
  */
 
 const searchAPIURL = APIBaseURL + "/search"
 
+// SearchMaxResultCount return_result_count 参数的最大值
+const SearchMaxResultCount = 5
+
 // SearchFaceResponse 搜索接口返响应数据
 type SearchFaceResponse struct {
 	FaceResponse
-	Results    []*SearchResults   `json:"results"`    // 搜索结果对象数组
-	Thresholds map[string]float32 `json:"thresholds"` // 一组用于参考的置信度阈值
-	ImageId    string             `json:"image_id"`   // 传入的图片在系统中的标识
-	Faces      []*Face            `json:"faces"`      // 传入的图片中检测出的人脸数组
+	Results    []*SearchResults `json:"results"`    // 搜索结果对象数组
+	Thresholds Thresholds       `json:"thresholds"` // 一组用于参考的置信度阈值
+	ImageId    string           `json:"image_id"`   // 传入的图片在系统中的标识
+	Faces      []*Face          `json:"faces"`      // 传入的图片中检测出的人脸数组
+	far        FAR              // 默认误识率
+}
+
+// Matches 返回在误识率level下判断为同一个人的搜索结果，不传level时使用 FaceSDK.DefaultFAR
+// 结果本身没有阈值时（例如由接口返回的body解析得到）使用响应中的 Thresholds
+func (resp *SearchFaceResponse) Matches(level ...FAR) []*SearchResults {
+	return filterSearchResults(resp.Results, resp.Thresholds, farLevel(resp.far, level))
 }
 
 // SearchResults 搜索结果对象
@@ -28,11 +40,42 @@ type SearchResults struct {
 	FaceToken  string  `json:"face_token"` // 从 FaceSet 中搜索出的一个人脸标识 face_token
 	Confidence float32 `json:"confidence"` // 比对结果置信度，范围 [0,100]，小数点后3位有效数字，数字越大表示两个人脸越可能是同一个人
 	UserId     string  `json:"user_id"`    // 用户提供的人脸标识，如果未提供则为空
+
+	// 以下字段接口不返回，由 SDK 填充，多 FaceSet 搜索时标注结果来自哪个 FaceSet
+	FacesetToken string     `json:"faceset_token,omitempty"` // 结果所在 FaceSet 的标识，按 outer_id 搜索且未知时为空
+	OuterId      string     `json:"outer_id,omitempty"`      // 结果所在 FaceSet 的 outer_id，按 faceset_token 搜索且未知时为空
+	Thresholds   Thresholds `json:"thresholds,omitempty"`    // 结果所在 FaceSet 搜索时返回的阈值，随结果一起序列化，缓存后仍能做出相同判断
+}
+
+// MatchesAt 使用结果自带的 Thresholds 判断搜索结果在误识率level下是否为同一个人，没有阈值时返回false
+func (sr *SearchResults) MatchesAt(level FAR) bool {
+	return sr.Thresholds.Match(sr.Confidence, level)
+}
+
+// MatchesWith 使用指定的阈值判断搜索结果在误识率level下是否为同一个人
+func (sr *SearchResults) MatchesWith(thresholds Thresholds, level FAR) bool {
+	return thresholds.Match(sr.Confidence, level)
+}
+
+// 筛选在误识率level下判断为同一个人的搜索结果，结果没有阈值时使用fallback
+func filterSearchResults(results []*SearchResults, fallback Thresholds, level FAR) []*SearchResults {
+	matches := make([]*SearchResults, 0)
+	for _, result := range results {
+		thresholds := result.Thresholds
+		if thresholds == nil {
+			thresholds = fallback
+		}
+		if result.MatchesWith(thresholds, level) {
+			matches = append(matches, result)
+		}
+	}
+	return matches
 }
 
 // SearchRequest 人脸搜索对象
 type SearchRequest struct {
 	FaceRequest
+	far FAR
 }
 
 // Search 构建一个人脸比对对象
@@ -47,9 +90,11 @@ func (sdk *FaceSDK) Search(options ...map[string]interface{}) (*SearchRequest, e
 	searchRequest.options["api_key"] = sdk.APIKey
 	searchRequest.options["api_secret"] = sdk.APISecret
 
+	searchRequest.far = sdk.DefaultFAR
 	searchRequest.request = sdk.getHTTPRequest().
 		Post(searchAPIURL).
 		Type("multipart")
+	searchRequest.retry = sdk.retryPolicy()
 
 	return searchRequest, nil
 }
@@ -72,6 +117,22 @@ func (sc *SearchRequest) SetFaceSet(set, dt string) *SearchRequest {
 	return sc
 }
 
+// SetFaceRectangle 指定图片中要搜索的人脸框，不指定时使用最大的人脸
+func (sc *SearchRequest) SetFaceRectangle(rect FaceRectangle) *SearchRequest {
+	sc.options["face_rectangle"] = rect.String()
+	return sc
+}
+
+// SetDetectedFace 使用之前检测结果中的第index个人脸进行搜索
+func (sc *SearchRequest) SetDetectedFace(dr *DetectFaceResponse, index int) *SearchRequest {
+	face, err := dr.Face(index)
+	if err != nil {
+		sc.err = err
+		return sc
+	}
+	return sc.SetFace(face.FaceToken, "face_token")
+}
+
 // SetOption 设置请求参数
 func (sc *SearchRequest) SetOption(key string, val interface{}) *SearchRequest {
 	sc.options[key] = val
@@ -88,19 +149,123 @@ func (sc *SearchRequest) SetOptionMap(options map[string]interface{}) *SearchReq
 
 // End 发送请求获取结果
 func (sc *SearchRequest) End() (*SearchFaceResponse, string, error) {
-	resp, body, errs := sc.request.SendMap(sc.options).End()
-	if len(errs) > 0 {
-		return nil, "", errors.New("请求接口错误:" + errs[0].Error())
+	if sc.err != nil {
+		return nil, "", sc.err
 	}
-	// 判断响应是否成功
-	if resp.StatusCode != http.StatusOK {
-		return nil, "", NewFaceError(resp.StatusCode, body)
+	body, err := sc.send()
+	if err != nil {
+		return nil, "", err
 	}
 	// 解析body为对象
 	searchFaceResponse := new(SearchFaceResponse)
-	err := json.Unmarshal([]byte(body), searchFaceResponse)
+	err = json.Unmarshal([]byte(body), searchFaceResponse)
 	if err != nil {
 		return nil, "", err
 	}
+	searchFaceResponse.far = sc.far
+	for _, result := range searchFaceResponse.Results {
+		result.Thresholds = searchFaceResponse.Thresholds
+	}
 	return searchFaceResponse, body, nil
 }
+
+// 检测图片并返回其中最大人脸的face_token，dt为face_token时直接返回
+// dt可以是(face_token|image_url|image_file|image_base64)
+func (sdk *FaceSDK) probeFace(face, dt string) (string, *DetectFaceResponse, error) {
+	if dt == "face_token" {
+		return face, nil, nil
+	}
+	detect, err := sdk.Detect()
+	if err != nil {
+		return "", nil, err
+	}
+	dr, _, err := detect.SetImage(face, dt).End()
+	if err != nil {
+		return "", nil, err
+	}
+	largest := largestFace(dr.Faces)
+	if largest == nil {
+		return "", dr, errors.New("图片中没有检测到人脸")
+	}
+	return largest.FaceToken, dr, nil
+}
+
+// 返回人脸框面积最大的人脸
+func largestFace(faces []*Face) *Face {
+	var largest *Face
+	for _, face := range faces {
+		if largest == nil ||
+			face.FaceRectangle.Width*face.FaceRectangle.Height > largest.FaceRectangle.Width*largest.FaceRectangle.Height {
+			largest = face
+		}
+	}
+	return largest
+}
+
+// 使用同一个face_token并发搜索多个FaceSet，返回与sets顺序一致的搜索结果，并在结果中标注所属FaceSet
+// 优先使用FacesetToken，为空时使用OuterId；FaceSet为空（EMPTY_FACESET）时对应的结果为没有搜索结果的空响应
+func (sdk *FaceSDK) searchFaceSets(ctx context.Context, faceToken string, sets []*Faceset, topK, concurrency int) ([]*SearchFaceResponse, error) {
+	if topK > SearchMaxResultCount {
+		topK = SearchMaxResultCount
+	}
+	responses := make([]*SearchFaceResponse, len(sets))
+	err := parallel(ctx, len(sets), concurrency, func(i int) error {
+		search, err := sdk.Search()
+		if err != nil {
+			return err
+		}
+		search.withContext(ctx)
+		if topK > 0 {
+			search.SetOption("return_result_count", topK)
+		}
+		if sets[i].FacesetToken != "" {
+			search.SetFaceSet(sets[i].FacesetToken, "faceset_token")
+		} else {
+			search.SetFaceSet(sets[i].OuterId, "outer_id")
+		}
+		sr, _, err := search.SetFace(faceToken, "face_token").End()
+		if IsFaceError(err, ErrorEmptyFaceSet) {
+			responses[i] = &SearchFaceResponse{Results: make([]*SearchResults, 0)}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, result := range sr.Results {
+			result.FacesetToken = sets[i].FacesetToken
+			result.OuterId = sets[i].OuterId
+		}
+		responses[i] = sr
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
+// 合并多个搜索结果，相同face_token保留置信度最高的一个，按置信度从高到低排序后取前topK个
+func mergeSearchResults(responses []*SearchFaceResponse, topK int) []*SearchResults {
+	best := make(map[string]*SearchResults)
+	results := make([]*SearchResults, 0)
+	for _, sr := range responses {
+		for _, result := range sr.Results {
+			if exist, ok := best[result.FaceToken]; ok {
+				if result.Confidence > exist.Confidence {
+					*exist = *result
+				}
+				continue
+			}
+			r := *result
+			best[result.FaceToken] = &r
+			results = append(results, &r)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Confidence > results[j].Confidence
+	})
+	if topK > 0 && len(results) > topK {
+		results = results[:topK]
+	}
+	return results
+}
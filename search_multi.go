@@ -0,0 +1,159 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+)
+
+/**
+ * 在多个 FaceSet 中搜索人脸
+ * search 接口一次只能搜索一个 FaceSet。这里只检测一次图片，使用其中最大人脸的 face_token 并发搜索各个 FaceSet，
+ * 合并去重后按置信度从高到低返回，每个结果标注其所在的 FaceSet。
+ * 没有 face_token 的 FaceSet（EMPTY_FACESET）不会导致整个搜索失败，只是没有结果。
+ */
+
+// MultiSearchFaceResponse 多FaceSet搜索响应数据
+type MultiSearchFaceResponse struct {
+	FaceToken  string                `json:"face_token"` // 用于搜索的人脸标识
+	Results    []*SearchResults      `json:"results"`    // 合并后的搜索结果，按置信度从高到低排序
+	Thresholds map[string]Thresholds `json:"thresholds"` // 每个 FaceSet 的置信度阈值，下标为 faceset_token，未知时为 outer_id，空 FaceSet 没有阈值
+	ImageId    string                `json:"image_id"`   // 传入的图片在系统中的标识，使用face_token搜索时为空
+	Faces      []*Face               `json:"faces"`      // 传入的图片中检测出的人脸数组，使用face_token搜索时为空
+	far        FAR                   // 默认误识率
+}
+
+// Matches 返回在误识率level下判断为同一个人的搜索结果，每个结果使用其所在 FaceSet 的阈值，不传level时使用 FaceSDK.DefaultFAR
+func (resp *MultiSearchFaceResponse) Matches(level ...FAR) []*SearchResults {
+	return filterSearchResults(resp.Results, nil, farLevel(resp.far, level))
+}
+
+// MultiSearchRequest 多FaceSet人脸搜索对象
+type MultiSearchRequest struct {
+	sdk         *FaceSDK
+	face        string
+	dt          string
+	sets        []*Faceset
+	tags        []string
+	topK        int
+	concurrency int
+}
+
+// MultiSearch 构建一个多FaceSet人脸搜索对象
+func (sdk *FaceSDK) MultiSearch() *MultiSearchRequest {
+	return &MultiSearchRequest{
+		sdk:  sdk,
+		sets: make([]*Faceset, 0),
+	}
+}
+
+// SetFace 设置要搜索的图片
+// dt可以是(face_token|image_url|image_file|image_base64)
+func (msr *MultiSearchRequest) SetFace(face, dt string) *MultiSearchRequest {
+	msr.face = face
+	msr.dt = dt
+	return msr
+}
+
+// SetFaceSets 设置要查找的多个faceset
+// dt可以是(faceset_token|outer_id)
+func (msr *MultiSearchRequest) SetFaceSets(sets []string, dt string) *MultiSearchRequest {
+	for _, set := range sets {
+		faceset := new(Faceset)
+		if dt == "faceset_token" {
+			faceset.FacesetToken = set
+		} else {
+			faceset.OuterId = set
+		}
+		msr.sets = append(msr.sets, faceset)
+	}
+	return msr
+}
+
+// SetFaceSetTags 搜索包含这些标签的全部faceset，可以和SetFaceSets同时使用
+func (msr *MultiSearchRequest) SetFaceSetTags(tags ...string) *MultiSearchRequest {
+	msr.tags = append(msr.tags, tags...)
+	return msr
+}
+
+// SetTopK 设置返回的结果数量，默认返回全部合并后的结果
+func (msr *MultiSearchRequest) SetTopK(topK int) *MultiSearchRequest {
+	msr.topK = topK
+	return msr
+}
+
+// SetConcurrency 设置并发请求数
+func (msr *MultiSearchRequest) SetConcurrency(concurrency int) *MultiSearchRequest {
+	msr.concurrency = concurrency
+	return msr
+}
+
+// End 发送请求获取结果
+func (msr *MultiSearchRequest) End(ctx context.Context) (*MultiSearchFaceResponse, error) {
+	sets := msr.sets
+	if len(msr.tags) > 0 {
+		facesets, err := msr.sdk.GetFaceSetsByTags(msr.tags...)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(append([]*Faceset{}, sets...), facesets...)
+	}
+	sets = uniqueFacesets(sets)
+	if len(sets) == 0 {
+		return nil, errors.New("没有要搜索的FaceSet")
+	}
+
+	faceToken, dr, err := msr.sdk.probeFace(msr.face, msr.dt)
+	if err != nil {
+		return nil, err
+	}
+	perSet := msr.topK
+	if perSet <= 0 {
+		perSet = SearchMaxResultCount
+	}
+	responses, err := msr.sdk.searchFaceSets(ctx, faceToken, sets, perSet, msr.concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	multiSearchFaceResponse := &MultiSearchFaceResponse{
+		FaceToken:  faceToken,
+		Results:    mergeSearchResults(responses, msr.topK),
+		Thresholds: make(map[string]Thresholds, len(sets)),
+		far:        msr.sdk.DefaultFAR,
+	}
+	for i, sr := range responses {
+		if sr.Thresholds == nil {
+			continue
+		}
+		key := sets[i].FacesetToken
+		if key == "" {
+			key = sets[i].OuterId
+		}
+		multiSearchFaceResponse.Thresholds[key] = sr.Thresholds
+	}
+	if dr != nil {
+		multiSearchFaceResponse.ImageId = dr.ImageId
+		multiSearchFaceResponse.Faces = dr.Faces
+	}
+	return multiSearchFaceResponse, nil
+}
+
+// 去除重复的FaceSet
+func uniqueFacesets(sets []*Faceset) []*Faceset {
+	seen := make(map[string]bool)
+	unique := make([]*Faceset, 0, len(sets))
+	for _, set := range sets {
+		if (set.FacesetToken != "" && seen["faceset_token:"+set.FacesetToken]) ||
+			(set.OuterId != "" && seen["outer_id:"+set.OuterId]) {
+			continue
+		}
+		if set.FacesetToken != "" {
+			seen["faceset_token:"+set.FacesetToken] = true
+		}
+		if set.OuterId != "" {
+			seen["outer_id:"+set.OuterId] = true
+		}
+		unique = append(unique, set)
+	}
+	return unique
+}
@@ -0,0 +1,183 @@
+package sdk
+
+import (
+	"encoding/json"
+)
+
+/**
+ * 皮肤分析
+ * 对图片中最大的人脸进行面部皮肤分析，包括眼袋、黑眼圈、皱纹、毛孔、黑头、痘痘、斑点和肤质等。
+ * 进阶版额外返回肤色、肤龄、各项问题的严重程度以及痘痘、痣、斑点的位置。
+ */
+
+const (
+	skinAnalyzeAPIURL         = APIHost + "/facepp/v1/skinanalyze"
+	skinAnalyzeAdvancedAPIURL = APIHost + "/facepp/v1/skinanalyze_advanced"
+)
+
+// SkinValue 皮肤分析单项结果
+type SkinValue struct {
+	Value      json.Number `json:"value"`      // 分析结果，一般0表示无 1表示有，部分项目为类型或严重程度
+	Confidence float32     `json:"confidence"` // 置信度，范围[0,1]
+}
+
+// Int 将分析结果转换为整数
+func (sv SkinValue) Int() int {
+	v, _ := sv.Value.Int64()
+	return int(v)
+}
+
+// Has 判断是否存在该皮肤问题
+func (sv SkinValue) Has() bool {
+	return sv.Int() != 0
+}
+
+// SkinType 肤质分析结果
+type SkinType struct {
+	SkinType int                  `json:"skin_type"` // 肤质 0 油性皮肤 1 干性皮肤 2 中性皮肤 3 混合性皮肤
+	Details  map[string]SkinValue `json:"details"`   // 每种肤质的置信度，下标为肤质类型
+}
+
+// SkinAnalyzeResult 皮肤分析结果
+type SkinAnalyzeResult struct {
+	LeftEyelids     SkinValue `json:"left_eyelids"`      // 左眼双眼皮 0 单眼皮 1 平行双眼皮 2 扇形双眼皮
+	RightEyelids    SkinValue `json:"right_eyelids"`     // 右眼双眼皮 0 单眼皮 1 平行双眼皮 2 扇形双眼皮
+	EyePouch        SkinValue `json:"eye_pouch"`         // 眼袋
+	DarkCircle      SkinValue `json:"dark_circle"`       // 黑眼圈
+	ForeheadWrinkle SkinValue `json:"forehead_wrinkle"`  // 抬头纹
+	CrowsFeet       SkinValue `json:"crows_feet"`        // 鱼尾纹
+	EyeFinelines    SkinValue `json:"eye_finelines"`     // 眼部细纹
+	GlabellaWrinkle SkinValue `json:"glabella_wrinkle"`  // 眉间纹
+	NasolabialFold  SkinValue `json:"nasolabial_fold"`   // 法令纹
+	SkinType        SkinType  `json:"skin_type"`         // 肤质
+	PoresForehead   SkinValue `json:"pores_forehead"`    // 前额毛孔粗大
+	PoresLeftCheek  SkinValue `json:"pores_left_cheek"`  // 左脸颊毛孔粗大
+	PoresRightCheek SkinValue `json:"pores_right_cheek"` // 右脸颊毛孔粗大
+	PoresJaw        SkinValue `json:"pores_jaw"`         // 下巴毛孔粗大
+	Blackhead       SkinValue `json:"blackhead"`         // 黑头
+	Acne            SkinValue `json:"acne"`              // 痘痘
+	Mole            SkinValue `json:"mole"`              // 痣
+	SkinSpot        SkinValue `json:"skin_spot"`         // 斑点
+}
+
+// SkinAnalyzeFaceResponse 皮肤分析响应数据
+type SkinAnalyzeFaceResponse struct {
+	FaceResponse
+	FaceRectangle FaceRectangle     `json:"face_rectangle"` // 被分析的人脸框位置
+	Result        SkinAnalyzeResult `json:"result"`         // 皮肤分析结果
+}
+
+// SkinRegions 皮肤问题所在的区域
+type SkinRegions struct {
+	Rectangle  []FaceRectangle `json:"rectangle"`  // 每个问题区域的矩形框
+	Confidence []float32       `json:"confidence"` // 每个问题区域的置信度
+}
+
+// SkinAnalyzeAdvancedResult 进阶版皮肤分析结果
+// 与基础版同名的 DarkCircle、Blackhead、Acne、Mole、SkinSpot 以进阶版字段为准
+type SkinAnalyzeAdvancedResult struct {
+	SkinAnalyzeResult
+	SkinColor              SkinValue   `json:"skin_color"`               // 肤色 0 透白 1 白皙 2 自然 3 小麦 4 黝黑
+	SkinAge                SkinValue   `json:"skin_age"`                 // 肤龄
+	EyePouchSeverity       SkinValue   `json:"eye_pouch_severity"`       // 眼袋严重程度 0 轻度 1 中度 2 重度
+	NasolabialFoldSeverity SkinValue   `json:"nasolabial_fold_severity"` // 法令纹严重程度 0 轻度 1 中度 2 重度
+	DarkCircle             SkinValue   `json:"dark_circle"`              // 黑眼圈类型 0 无 1 色素型 2 血管型 3 阴影型
+	Blackhead              SkinValue   `json:"blackhead"`                // 黑头严重程度 0 无 1 轻度 2 中度 3 重度
+	Acne                   SkinRegions `json:"acne"`                     // 痘痘位置
+	Mole                   SkinRegions `json:"mole"`                     // 痣位置
+	SkinSpot               SkinRegions `json:"skin_spot"`                // 斑点位置
+	ClosedComedones        SkinRegions `json:"closed_comedones"`         // 闭口位置
+}
+
+// SkinAnalyzeAdvancedFaceResponse 进阶版皮肤分析响应数据
+type SkinAnalyzeAdvancedFaceResponse struct {
+	FaceResponse
+	FaceRectangle FaceRectangle             `json:"face_rectangle"` // 被分析的人脸框位置
+	Result        SkinAnalyzeAdvancedResult `json:"result"`         // 皮肤分析结果
+}
+
+// SkinAnalyzeRequest 皮肤分析对象
+type SkinAnalyzeRequest struct {
+	FaceRequest
+}
+
+// SkinAnalyze 构建一个皮肤分析对象
+func (sdk *FaceSDK) SkinAnalyze(options ...map[string]interface{}) (*SkinAnalyzeRequest, error) {
+	skinAnalyzeRequest := new(SkinAnalyzeRequest)
+	skinAnalyzeRequest.FaceRequest = sdk.newFaceRequest(skinAnalyzeAPIURL, options)
+	return skinAnalyzeRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (sar *SkinAnalyzeRequest) SetImage(img, dt string) *SkinAnalyzeRequest {
+	sar.setImage(img, dt)
+	return sar
+}
+
+// SetOption 设置请求参数
+func (sar *SkinAnalyzeRequest) SetOption(key string, val interface{}) *SkinAnalyzeRequest {
+	sar.options[key] = val
+	return sar
+}
+
+// SetOptionMap 通过map设置请求参数
+func (sar *SkinAnalyzeRequest) SetOptionMap(options map[string]interface{}) *SkinAnalyzeRequest {
+	for key, val := range options {
+		sar.options[key] = val
+	}
+	return sar
+}
+
+// End 发送请求获取结果
+func (sar *SkinAnalyzeRequest) End() (*SkinAnalyzeFaceResponse, string, error) {
+	skinAnalyzeFaceResponse := new(SkinAnalyzeFaceResponse)
+	body, err := sar.end(skinAnalyzeFaceResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return skinAnalyzeFaceResponse, body, nil
+}
+
+// SkinAnalyzeAdvancedRequest 进阶版皮肤分析对象
+type SkinAnalyzeAdvancedRequest struct {
+	FaceRequest
+}
+
+// SkinAnalyzeAdvanced 构建一个进阶版皮肤分析对象
+func (sdk *FaceSDK) SkinAnalyzeAdvanced(options ...map[string]interface{}) (*SkinAnalyzeAdvancedRequest, error) {
+	skinAnalyzeAdvancedRequest := new(SkinAnalyzeAdvancedRequest)
+	skinAnalyzeAdvancedRequest.FaceRequest = sdk.newFaceRequest(skinAnalyzeAdvancedAPIURL, options)
+	return skinAnalyzeAdvancedRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (saar *SkinAnalyzeAdvancedRequest) SetImage(img, dt string) *SkinAnalyzeAdvancedRequest {
+	saar.setImage(img, dt)
+	return saar
+}
+
+// SetOption 设置请求参数
+func (saar *SkinAnalyzeAdvancedRequest) SetOption(key string, val interface{}) *SkinAnalyzeAdvancedRequest {
+	saar.options[key] = val
+	return saar
+}
+
+// SetOptionMap 通过map设置请求参数
+func (saar *SkinAnalyzeAdvancedRequest) SetOptionMap(options map[string]interface{}) *SkinAnalyzeAdvancedRequest {
+	for key, val := range options {
+		saar.options[key] = val
+	}
+	return saar
+}
+
+// End 发送请求获取结果
+func (saar *SkinAnalyzeAdvancedRequest) End() (*SkinAnalyzeAdvancedFaceResponse, string, error) {
+	skinAnalyzeAdvancedFaceResponse := new(SkinAnalyzeAdvancedFaceResponse)
+	body, err := saar.end(skinAnalyzeAdvancedFaceResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return skinAnalyzeAdvancedFaceResponse, body, nil
+}
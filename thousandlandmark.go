@@ -0,0 +1,201 @@
+package sdk
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+/**
+ * 稠密人脸关键点
+ * 对图片中最大的人脸返回约 1000 个关键点，按脸部轮廓、眉毛、眼睛、眼皮、鼻子、嘴巴分区域返回。
+ * 眼睛区域中除眼睛轮廓关键点外还包含眼球中心（*_eye_pupil_center）和眼球半径（*_eye_pupil_radius，为数值），
+ * 使用 EyeLandmark 分别解析。
+ */
+
+const thousandLandmarkAPIURL = APIHost + "/facepp/v1/face/thousandlandmark"
+
+// LandmarkRegion 稠密关键点的区域
+type LandmarkRegion string
+
+const (
+	LandmarkRegionAll            LandmarkRegion = "all"              // 全部区域
+	LandmarkRegionFace           LandmarkRegion = "face"             // 脸部轮廓
+	LandmarkRegionLeftEyebrow    LandmarkRegion = "left_eyebrow"     // 左眉毛
+	LandmarkRegionRightEyebrow   LandmarkRegion = "right_eyebrow"    // 右眉毛
+	LandmarkRegionLeftEye        LandmarkRegion = "left_eye"         // 左眼，包含眼球关键点
+	LandmarkRegionLeftEyeEyelid  LandmarkRegion = "left_eye_eyelid"  // 左眼皮
+	LandmarkRegionRightEye       LandmarkRegion = "right_eye"        // 右眼，包含眼球关键点
+	LandmarkRegionRightEyeEyelid LandmarkRegion = "right_eye_eyelid" // 右眼皮
+	LandmarkRegionNose           LandmarkRegion = "nose"             // 鼻子
+	LandmarkRegionMouth          LandmarkRegion = "mouth"            // 嘴巴
+)
+
+// DenseLandmark 按区域分组的稠密关键点，每个区域的下标为关键点名称，未请求的区域为nil
+type DenseLandmark struct {
+	Face           map[string]*Landmark `json:"face"`             // 脸部轮廓
+	LeftEyebrow    map[string]*Landmark `json:"left_eyebrow"`     // 左眉毛
+	RightEyebrow   map[string]*Landmark `json:"right_eyebrow"`    // 右眉毛
+	LeftEye        *EyeLandmark         `json:"left_eye"`         // 左眼
+	LeftEyeEyelid  map[string]*Landmark `json:"left_eye_eyelid"`  // 左眼皮
+	RightEye       *EyeLandmark         `json:"right_eye"`        // 右眼
+	RightEyeEyelid map[string]*Landmark `json:"right_eye_eyelid"` // 右眼皮
+	Nose           map[string]*Landmark `json:"nose"`             // 鼻子
+	Mouth          map[string]*Landmark `json:"mouth"`            // 嘴巴
+}
+
+// Region 获取指定区域的关键点，眼睛区域只返回眼睛轮廓关键点，眼球信息通过 LeftEye、RightEye 获取
+func (dl *DenseLandmark) Region(region LandmarkRegion) map[string]*Landmark {
+	switch region {
+	case LandmarkRegionFace:
+		return dl.Face
+	case LandmarkRegionLeftEyebrow:
+		return dl.LeftEyebrow
+	case LandmarkRegionRightEyebrow:
+		return dl.RightEyebrow
+	case LandmarkRegionLeftEye:
+		return dl.LeftEye.points()
+	case LandmarkRegionLeftEyeEyelid:
+		return dl.LeftEyeEyelid
+	case LandmarkRegionRightEye:
+		return dl.RightEye.points()
+	case LandmarkRegionRightEyeEyelid:
+		return dl.RightEyeEyelid
+	case LandmarkRegionNose:
+		return dl.Nose
+	case LandmarkRegionMouth:
+		return dl.Mouth
+	}
+	return nil
+}
+
+const (
+	pupilCenterSuffix = "_pupil_center" // 眼球中心关键点名称后缀
+	pupilRadiusSuffix = "_pupil_radius" // 眼球半径名称后缀
+)
+
+// EyeLandmark 眼睛区域的稠密关键点
+type EyeLandmark struct {
+	Points      map[string]*Landmark // 眼睛轮廓关键点，下标为关键点名称
+	PupilCenter *Landmark            // 眼球中心，未返回时为nil
+	PupilRadius float32              // 眼球半径
+	prefix      string               // 眼球关键点名称前缀 left_eye|right_eye
+}
+
+// UnmarshalJSON 将眼球中心和眼球半径从眼睛轮廓关键点中分离
+func (el *EyeLandmark) UnmarshalJSON(data []byte) error {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	el.Points = make(map[string]*Landmark, len(fields))
+	for name, raw := range fields {
+		switch {
+		case strings.HasSuffix(name, pupilRadiusSuffix):
+			el.prefix = strings.TrimSuffix(name, pupilRadiusSuffix)
+			if err := json.Unmarshal(raw, &el.PupilRadius); err != nil {
+				return err
+			}
+		case strings.HasSuffix(name, pupilCenterSuffix):
+			el.prefix = strings.TrimSuffix(name, pupilCenterSuffix)
+			el.PupilCenter = new(Landmark)
+			if err := json.Unmarshal(raw, el.PupilCenter); err != nil {
+				return err
+			}
+		default:
+			landmark := new(Landmark)
+			if err := json.Unmarshal(raw, landmark); err != nil {
+				return err
+			}
+			el.Points[name] = landmark
+		}
+	}
+	return nil
+}
+
+// MarshalJSON 按接口返回的格式序列化
+func (el *EyeLandmark) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{}, len(el.Points)+2)
+	for name, landmark := range el.Points {
+		fields[name] = landmark
+	}
+	if el.prefix != "" {
+		if el.PupilCenter != nil {
+			fields[el.prefix+pupilCenterSuffix] = el.PupilCenter
+		}
+		fields[el.prefix+pupilRadiusSuffix] = el.PupilRadius
+	}
+	return json.Marshal(fields)
+}
+
+// 眼睛轮廓关键点，el为nil时返回nil
+func (el *EyeLandmark) points() map[string]*Landmark {
+	if el == nil {
+		return nil
+	}
+	return el.Points
+}
+
+// ThousandLandmarkFaceResponse 稠密人脸关键点响应数据
+type ThousandLandmarkFaceResponse struct {
+	FaceResponse
+	Face struct {
+		Landmark      DenseLandmark `json:"landmark"`       // 按区域分组的关键点
+		FaceRectangle FaceRectangle `json:"face_rectangle"` // 人脸矩形框的位置
+	} `json:"face"` // 被分析的人脸
+}
+
+// ThousandLandmarkRequest 稠密人脸关键点对象
+type ThousandLandmarkRequest struct {
+	FaceRequest
+}
+
+// ThousandLandmark 构建一个稠密人脸关键点对象，默认返回全部区域
+func (sdk *FaceSDK) ThousandLandmark(options ...map[string]interface{}) (*ThousandLandmarkRequest, error) {
+	thousandLandmarkRequest := new(ThousandLandmarkRequest)
+	thousandLandmarkRequest.FaceRequest = sdk.newFaceRequest(thousandLandmarkAPIURL, options)
+	if _, ok := thousandLandmarkRequest.options["return_landmark"]; !ok {
+		thousandLandmarkRequest.options["return_landmark"] = string(LandmarkRegionAll)
+	}
+	return thousandLandmarkRequest, nil
+}
+
+// SetImage 设置图片信息
+// dt可以是(image_url|image_file|image_base64)
+func (tlr *ThousandLandmarkRequest) SetImage(img, dt string) *ThousandLandmarkRequest {
+	tlr.setImage(img, dt)
+	return tlr
+}
+
+// SetReturnLandmark 设置要返回的关键点区域
+func (tlr *ThousandLandmarkRequest) SetReturnLandmark(regions ...LandmarkRegion) *ThousandLandmarkRequest {
+	list := make([]string, len(regions))
+	for i, region := range regions {
+		list[i] = string(region)
+	}
+	tlr.options["return_landmark"] = strings.Join(list, ",")
+	return tlr
+}
+
+// SetOption 设置请求参数
+func (tlr *ThousandLandmarkRequest) SetOption(key string, val interface{}) *ThousandLandmarkRequest {
+	tlr.options[key] = val
+	return tlr
+}
+
+// SetOptionMap 通过map设置请求参数
+func (tlr *ThousandLandmarkRequest) SetOptionMap(options map[string]interface{}) *ThousandLandmarkRequest {
+	for key, val := range options {
+		tlr.options[key] = val
+	}
+	return tlr
+}
+
+// End 发送请求获取结果
+func (tlr *ThousandLandmarkRequest) End() (*ThousandLandmarkFaceResponse, string, error) {
+	thousandLandmarkFaceResponse := new(ThousandLandmarkFaceResponse)
+	body, err := tlr.end(thousandLandmarkFaceResponse)
+	if err != nil {
+		return nil, "", err
+	}
+	return thousandLandmarkFaceResponse, body, nil
+}
@@ -0,0 +1,56 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestThousandLandmarkEyeRegions(t *testing.T) {
+	body := `{"request_id":"1","time_used":10,"face":{"face_rectangle":{"top":1,"left":2,"width":3,"height":4},"landmark":{
+		"nose":{"nose_0":{"x":3,"y":4}},
+		"left_eye":{"left_eye_0":{"x":1,"y":2},"left_eye_1":{"x":3,"y":2},"left_eye_pupil_center":{"x":5,"y":6},"left_eye_pupil_radius":9},
+		"right_eye":{"right_eye_0":{"x":7,"y":2},"right_eye_pupil_radius":8.5}}}}`
+	resp := new(ThousandLandmarkFaceResponse)
+	if err := json.Unmarshal([]byte(body), resp); err != nil {
+		t.Fatal(err)
+	}
+	landmark := resp.Face.Landmark
+
+	left := landmark.LeftEye
+	if left == nil || len(left.Points) != 2 || left.Points["left_eye_1"] == nil {
+		t.Fatalf("LeftEye.Points = %+v", left)
+	}
+	if left.PupilCenter == nil || left.PupilCenter.X != 5.0 || left.PupilCenter.Y != 6.0 || left.PupilRadius != 9 {
+		t.Fatalf("LeftEye pupil = %+v, %v", left.PupilCenter, left.PupilRadius)
+	}
+	right := landmark.RightEye
+	if right == nil || len(right.Points) != 1 || right.PupilCenter != nil || right.PupilRadius != 8.5 {
+		t.Fatalf("RightEye = %+v", right)
+	}
+	if len(landmark.Region(LandmarkRegionLeftEye)) != 2 || len(landmark.Region(LandmarkRegionNose)) != 1 || landmark.Region(LandmarkRegionMouth) != nil {
+		t.Fatal("Region returned unexpected points")
+	}
+
+	// 序列化后保持接口返回的格式
+	data, err := json.Marshal(left)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if string(fields["left_eye_pupil_radius"]) != "9" || fields["left_eye_pupil_center"] == nil || len(fields) != 4 {
+		t.Fatalf("MarshalJSON = %s", data)
+	}
+}
+
+func TestThousandLandmarkEyeRegionNotRequested(t *testing.T) {
+	resp := new(ThousandLandmarkFaceResponse)
+	if err := json.Unmarshal([]byte(`{"face":{"landmark":{"mouth":{"mouth_0":{"x":1,"y":1}}}}}`), resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Face.Landmark.LeftEye != nil || resp.Face.Landmark.Region(LandmarkRegionRightEye) != nil {
+		t.Fatal("eye regions should be nil when not requested")
+	}
+}
@@ -0,0 +1,45 @@
+package sdk
+
+/**
+ * 比对、搜索接口返回的置信度阈值
+ * thresholds 包含 1e-3、1e-4、1e-5 三个误识率下的阈值，置信度不低于某一误识率下的阈值时，
+ * 可以认为两个人脸是同一个人，误识率越低判断越严格。
+ */
+
+// FAR 误识率
+type FAR string
+
+const (
+	FAR1e3 FAR = "1e-3" // 千分之一误识率
+	FAR1e4 FAR = "1e-4" // 万分之一误识率
+	FAR1e5 FAR = "1e-5" // 十万分之一误识率
+)
+
+// DefaultFAR 未设置 FaceSDK.DefaultFAR 时使用的误识率
+const DefaultFAR = FAR1e4
+
+// Thresholds 不同误识率下的置信度阈值
+type Thresholds map[string]float32
+
+// At 获取误识率对应的阈值，接口未返回时ok为false
+func (t Thresholds) At(level FAR) (threshold float32, ok bool) {
+	threshold, ok = t[string(level)]
+	return threshold, ok
+}
+
+// Match 判断置信度在该误识率下是否为同一个人，没有对应阈值时返回false
+func (t Thresholds) Match(confidence float32, level FAR) bool {
+	threshold, ok := t.At(level)
+	return ok && confidence >= threshold
+}
+
+// 取第一个指定的误识率，未指定时使用def，def也为空时使用DefaultFAR
+func farLevel(def FAR, level []FAR) FAR {
+	if len(level) > 0 && level[0] != "" {
+		return level[0]
+	}
+	if def != "" {
+		return def
+	}
+	return DefaultFAR
+}
@@ -0,0 +1,136 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var testThresholds = Thresholds{"1e-3": 62.327, "1e-4": 69.101, "1e-5": 73.975}
+
+func TestThresholdsMatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		thresholds Thresholds
+		confidence float32
+		level      FAR
+		want       bool
+	}{
+		{"above", testThresholds, 70, FAR1e4, true},
+		{"equal", testThresholds, 69.101, FAR1e4, true},
+		{"below", testThresholds, 69, FAR1e4, false},
+		{"stricter level", testThresholds, 70, FAR1e5, false},
+		{"looser level", testThresholds, 63, FAR1e3, true},
+		{"unknown level", testThresholds, 99, FAR("1e-6"), false},
+		{"nil thresholds", nil, 99, FAR1e4, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.thresholds.Match(tt.confidence, tt.level); got != tt.want {
+				t.Errorf("Match(%v, %s) = %v, want %v", tt.confidence, tt.level, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFarLevel(t *testing.T) {
+	tests := []struct {
+		name  string
+		def   FAR
+		level []FAR
+		want  FAR
+	}{
+		{"explicit level", FAR1e3, []FAR{FAR1e5}, FAR1e5},
+		{"sdk default", FAR1e3, nil, FAR1e3},
+		{"empty explicit level uses sdk default", FAR1e3, []FAR{""}, FAR1e3},
+		{"empty sdk default", "", nil, FAR1e4},
+		{"all empty", "", []FAR{""}, DefaultFAR},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := farLevel(tt.def, tt.level); got != tt.want {
+				t.Errorf("farLevel(%q, %v) = %q, want %q", tt.def, tt.level, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchResultsMatches(t *testing.T) {
+	strict := Thresholds{"1e-4": 80}
+	tests := []struct {
+		name       string
+		result     SearchResults
+		level      FAR
+		withThresh Thresholds
+		wantAt     bool
+		wantWith   bool
+	}{
+		{"own thresholds", SearchResults{Confidence: 75, Thresholds: testThresholds}, FAR1e4, strict, true, false},
+		{"no own thresholds", SearchResults{Confidence: 85}, FAR1e4, strict, false, true},
+		{"below both", SearchResults{Confidence: 50, Thresholds: testThresholds}, FAR1e4, strict, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.result.MatchesAt(tt.level); got != tt.wantAt {
+				t.Errorf("MatchesAt = %v, want %v", got, tt.wantAt)
+			}
+			if got := tt.result.MatchesWith(tt.withThresh, tt.level); got != tt.wantWith {
+				t.Errorf("MatchesWith = %v, want %v", got, tt.wantWith)
+			}
+		})
+	}
+}
+
+func TestSearchFaceResponseMatches(t *testing.T) {
+	resp := &SearchFaceResponse{
+		Results: []*SearchResults{
+			{FaceToken: "own-strict", Confidence: 75, Thresholds: Thresholds{"1e-4": 80}},
+			{FaceToken: "fallback-pass", Confidence: 70},
+			{FaceToken: "fallback-fail", Confidence: 65},
+			{FaceToken: "own-loose", Confidence: 65, Thresholds: Thresholds{"1e-4": 60}},
+		},
+		Thresholds: testThresholds,
+	}
+	tests := []struct {
+		name  string
+		far   FAR
+		level []FAR
+		want  []string
+	}{
+		{"empty sdk default uses 1e-4", "", nil, []string{"fallback-pass", "own-loose"}},
+		{"sdk default", FAR1e3, nil, []string{"fallback-pass", "fallback-fail"}},
+		{"explicit level overrides default", FAR1e3, []FAR{FAR1e4}, []string{"fallback-pass", "own-loose"}},
+		{"level missing from thresholds", "", []FAR{FAR1e5}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp.far = tt.far
+			got := make([]string, 0)
+			for _, result := range resp.Matches(tt.level...) {
+				got = append(got, result.FaceToken)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Matches = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("Matches = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchResultsThresholdsRoundTrip(t *testing.T) {
+	// 缓存后重新解析的结果和原结果的判断一致
+	data, err := json.Marshal(&SearchResults{FaceToken: "a", Confidence: 70, Thresholds: testThresholds})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cached SearchResults
+	if err := json.Unmarshal(data, &cached); err != nil {
+		t.Fatal(err)
+	}
+	if !cached.MatchesAt(FAR1e4) || cached.MatchesAt(FAR1e5) {
+		t.Fatalf("cached result %s decided differently", data)
+	}
+}
@@ -0,0 +1,73 @@
+package sdk
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultConcurrency 组合接口默认的并发请求数，避免触发 CONCURRENCY_LIMIT_EXCEEDED
+const defaultConcurrency = 3
+
+/**
+ * parallel 以最多 concurrency 个并发执行 n 个任务
+ * 任意任务返回错误或 ctx 被取消后不再启动新任务，等待已启动的任务结束后返回第一个错误
+ * @param concurrency 并发数，小于等于0时使用默认值
+ */
+func parallel(ctx context.Context, n, concurrency int, fn func(i int) error) error {
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	setErr := func(err error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		mu.Unlock()
+	}
+	sem := make(chan struct{}, concurrency)
+loop:
+	for i := 0; i < n; i++ {
+		mu.Lock()
+		stop := firstErr != nil
+		mu.Unlock()
+		if stop {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			setErr(ctx.Err())
+			break loop
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(i); err != nil {
+				setErr(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// splitStrings 将字符串数组按size拆分为多组
+func splitStrings(list []string, size int) [][]string {
+	chunks := make([][]string, 0, (len(list)+size-1)/size)
+	for start := 0; start < len(list); start += size {
+		end := start + size
+		if end > len(list) {
+			end = len(list)
+		}
+		chunks = append(chunks, list[start:end])
+	}
+	return chunks
+}